@@ -0,0 +1,100 @@
+package concurrent
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ConfigBuilder assembles a Config through chained calls. Problems found by a
+// setter are kept and reported together by Build, so a chain never has to be
+// interrupted for error checks.
+type ConfigBuilder[T any] struct {
+	config Config[T]
+	errs   []error
+}
+
+// NewConfigBuilder returns a builder for an empty Config.
+func NewConfigBuilder[T any]() *ConfigBuilder[T] {
+	return &ConfigBuilder[T]{}
+}
+
+func (b *ConfigBuilder[T]) Name(name string) *ConfigBuilder[T] {
+	b.config.Name = name
+	return b
+}
+
+func (b *ConfigBuilder[T]) Concurrency(n int) *ConfigBuilder[T] {
+	b.config.Concurrency = n
+	return b
+}
+
+// MaxRetry sets how often a failed item is retried. Retries only happen when
+// the error policy returns ActionRetry, see RetryPolicy.
+func (b *ConfigBuilder[T]) MaxRetry(n int) *ConfigBuilder[T] {
+	b.config.MaxRetry = n
+	return b
+}
+
+func (b *ConfigBuilder[T]) Timeout(d time.Duration) *ConfigBuilder[T] {
+	b.config.Timeout = d
+	return b
+}
+
+func (b *ConfigBuilder[T]) Backoff(backoff BackoffFunc) *ConfigBuilder[T] {
+	if backoff == nil {
+		b.errs = append(b.errs, errors.New("backoff must not be nil"))
+	}
+	b.config.Backoff = backoff
+	return b
+}
+
+func (b *ConfigBuilder[T]) ConstantBackoff(delay time.Duration) *ConfigBuilder[T] {
+	if delay <= 0 {
+		b.errs = append(b.errs, fmt.Errorf("backoff delay must be > 0, got %v", delay))
+	}
+	b.config.Backoff = ConstantBackoff(delay)
+	return b
+}
+
+func (b *ConfigBuilder[T]) ExponentialBackoff(base, maxDelay time.Duration) *ConfigBuilder[T] {
+	if base <= 0 {
+		b.errs = append(b.errs, fmt.Errorf("backoff base must be > 0, got %v", base))
+	}
+	if maxDelay < 0 {
+		b.errs = append(b.errs, fmt.Errorf("backoff max delay must be >= 0, got %v", maxDelay))
+	}
+	b.config.Backoff = ExponentialBackoff(base, maxDelay)
+	return b
+}
+
+func (b *ConfigBuilder[T]) ErrorPolicy(policy ErrorPolicy[T]) *ConfigBuilder[T] {
+	if policy == nil {
+		b.errs = append(b.errs, errors.New("error policy must not be nil"))
+	}
+	b.config.ErrorPolicy = policy
+	return b
+}
+
+// RetryPolicy retries every error, up to MaxRetry times.
+func (b *ConfigBuilder[T]) RetryPolicy() *ConfigBuilder[T] {
+	return b.ErrorPolicy(AlwaysRetry[T]())
+}
+
+// AbortOnError aborts the run on the first item that fails.
+func (b *ConfigBuilder[T]) AbortOnError() *ConfigBuilder[T] {
+	return b.ErrorPolicy(AbortOnError[T]())
+}
+
+// Build returns the assembled Config after validating it. Defaults are not
+// applied; New does that.
+func (b *ConfigBuilder[T]) Build() (Config[T], error) {
+	errs := b.errs
+	if err := b.config.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return Config[T]{}, errors.Join(errs...)
+	}
+	return b.config, nil
+}
@@ -0,0 +1,98 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigBuilder_Build(t *testing.T) {
+	built, err := NewConfigBuilder[int]().
+		Name("import").
+		Concurrency(4).
+		MaxRetry(3).
+		Timeout(time.Second).
+		ExponentialBackoff(10*time.Millisecond, time.Second).
+		AbortOnError().
+		Build()
+	require.NoError(t, err)
+
+	want := Config[int]{
+		Name:        "import",
+		Concurrency: 4,
+		MaxRetry:    3,
+		Timeout:     time.Second,
+		Backoff:     ExponentialBackoff(10*time.Millisecond, time.Second),
+		ErrorPolicy: AbortOnError[int](),
+	}
+
+	assert.Equal(t, want.Name, built.Name)
+	assert.Equal(t, want.Concurrency, built.Concurrency)
+	assert.Equal(t, want.MaxRetry, built.MaxRetry)
+	assert.Equal(t, want.Timeout, built.Timeout)
+	for attempt := 1; attempt <= 8; attempt++ {
+		assert.Equal(t, want.Backoff(attempt), built.Backoff(attempt))
+	}
+	assert.Equal(t, want.ErrorPolicy(errors.New("x"), 1, 0), built.ErrorPolicy(errors.New("x"), 1, 0))
+	assert.Nil(t, built.PanicPolicy)
+
+	exec, err := New(built)
+	require.NoError(t, err)
+	result, err := exec.Run(context.Background(), []int{1}, func(context.Context, int) error {
+		return errors.New("boom")
+	})
+	require.NoError(t, err)
+	assert.True(t, result.Aborted)
+}
+
+func TestConfigBuilder_RetryPolicy(t *testing.T) {
+	built, err := NewConfigBuilder[int]().Concurrency(1).MaxRetry(2).RetryPolicy().Build()
+	require.NoError(t, err)
+	assert.Equal(t, ActionRetry, built.ErrorPolicy(errors.New("x"), 0, 0))
+}
+
+func TestConfigBuilder_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *ConfigBuilder[int]
+		wantErr string
+	}{
+		{
+			name:    "missing concurrency",
+			builder: NewConfigBuilder[int](),
+			wantErr: "concurrency must be > 0",
+		},
+		{
+			name:    "negative retry",
+			builder: NewConfigBuilder[int]().Concurrency(1).MaxRetry(-1),
+			wantErr: "max retry must be >= 0",
+		},
+		{
+			name:    "bad backoff base",
+			builder: NewConfigBuilder[int]().Concurrency(1).ExponentialBackoff(0, time.Second),
+			wantErr: "backoff base must be > 0",
+		},
+		{
+			name:    "nil policy",
+			builder: NewConfigBuilder[int]().Concurrency(1).ErrorPolicy(nil),
+			wantErr: "error policy must not be nil",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := tt.builder.Build()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+			assert.Zero(t, cfg.Concurrency)
+		})
+	}
+
+	_, err := NewConfigBuilder[int]().ConstantBackoff(0).Build()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "backoff delay must be > 0")
+	assert.Contains(t, err.Error(), "concurrency must be > 0")
+}
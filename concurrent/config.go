@@ -3,17 +3,51 @@ package concurrent
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 )
 
+const defaultMaxErrorSamples = 100
+
 // Config controls executor concurrency, retries, error handling, and callbacks.
 type Config[T any] struct {
+	// Name identifies the executor in Result.Name and, through
+	// NameFromContext, in the contexts passed to hooks and handlers.
 	Name string
 
 	Concurrency int
 
+	// ChannelBufferMultiplier sizes the queue between the producer and the
+	// workers at Concurrency*ChannelBufferMultiplier items. Raise it when
+	// Result.WorkerIdle shows workers waiting on a bursty producer. Zero
+	// means the default of 2.
+	ChannelBufferMultiplier int
+
 	Timeout time.Duration
 
+	// TimeoutFunc, when set, replaces Timeout with a per-item value; zero
+	// means no timeout for that item. A negative result fails the item.
+	TimeoutFunc func(item T) time.Duration
+
+	// TimeoutGrace, when positive together with the item's timeout, abandons
+	// a handler that is still running timeout+TimeoutGrace after it started.
+	// The task fails with ErrTaskAbandoned and the worker moves on; the
+	// handler's goroutine keeps running until it returns, so handlers that
+	// ignore ctx leak until they finish.
+	TimeoutGrace time.Duration
+
+	// SkipFunc, when set, is called before an item is handled; returning true
+	// counts the item in Result.Skipped without invoking the handler or the
+	// per-attempt hooks. Use it to resume a batch that partly succeeded.
+	SkipFunc func(item T) bool
+
+	// HedgeAfter, when positive, starts a second copy of an attempt that is
+	// still running after this long and keeps whichever copy returns first,
+	// canceling the other. The handler must tolerate running twice for the
+	// same item. A hedged attempt counts once in the result and in hooks;
+	// Result.Hedged counts how many hedges were started.
+	HedgeAfter time.Duration
+
 	MaxRetry int
 
 	Backoff BackoffFunc
@@ -24,8 +58,41 @@ type Config[T any] struct {
 
 	MaxErrorSamples int
 
+	// ErrorSampleStrategy selects whether ErrorSamples keeps the first or the
+	// last MaxErrorSamples errors. The zero value is SampleFirst.
+	ErrorSampleStrategy SampleStrategy
+
 	ErrorAggregation bool
 
+	// ErrorKeyFunc, when set, returns the Result.ErrorCount key for err in
+	// place of err.Error(), so errors that differ only in details such as
+	// record ids can be counted together.
+	ErrorKeyFunc func(err error) string
+
+	// RecordRetryHistory keeps the error of every failed attempt, grouped by
+	// task, in Result.RetryHistory.
+	RecordRetryHistory bool
+
+	// AbortFailureRate aborts the run once failed/(success+failed) exceeds
+	// this fraction in (0, 1] after AbortMinSamples items finished. Zero
+	// disables the check.
+	AbortFailureRate float64
+
+	AbortMinSamples int
+
+	// RecordAllAborts collects every abort request, including those from
+	// workers that failed at about the same time as the first, into
+	// Result.AbortReasons. Result.AbortReason always holds the first.
+	RecordAllAborts bool
+
+	// Logger, when set, logs the start of a run, each failed attempt at Warn
+	// with its task id and attempt, and the end of the run with its success
+	// rate and duration. It runs alongside the hooks below, not instead of them.
+	Logger *slog.Logger
+
+	// The hooks below should not panic. If one does, the panic is recovered,
+	// counted in Result.HookPanics, and logged through Logger when set; the
+	// run carries on as if the hook had returned.
 	OnBegin func(ctx context.Context, total int)
 
 	OnBefore func(ctx context.Context, item T, attempt int)
@@ -41,12 +108,30 @@ func (c *Config[T]) Validate() error {
 	if c.Concurrency <= 0 {
 		return fmt.Errorf("concurrency must be > 0, got %d", c.Concurrency)
 	}
+	if c.ChannelBufferMultiplier < 0 {
+		return fmt.Errorf("channel buffer multiplier must be >= 1, got %d", c.ChannelBufferMultiplier)
+	}
 	if c.MaxRetry < 0 {
 		return fmt.Errorf("max retry must be >= 0, got %d", c.MaxRetry)
 	}
 	if c.Timeout < 0 {
 		return fmt.Errorf("timeout must be >= 0, got %v", c.Timeout)
 	}
+	if c.HedgeAfter < 0 {
+		return fmt.Errorf("hedge after must be >= 0, got %v", c.HedgeAfter)
+	}
+	if c.TimeoutGrace < 0 {
+		return fmt.Errorf("timeout grace must be >= 0, got %v", c.TimeoutGrace)
+	}
+	if c.AbortFailureRate < 0 || c.AbortFailureRate > 1 {
+		return fmt.Errorf("abort failure rate must be within [0, 1], got %v", c.AbortFailureRate)
+	}
+	if c.ErrorSampleStrategy != SampleFirst && c.ErrorSampleStrategy != SampleLast {
+		return fmt.Errorf("unknown error sample strategy %d", c.ErrorSampleStrategy)
+	}
+	if c.AbortMinSamples < 0 {
+		return fmt.Errorf("abort min samples must be >= 0, got %d", c.AbortMinSamples)
+	}
 	return nil
 }
 
@@ -54,6 +139,9 @@ func (c *Config[T]) SetDefaults() {
 	if c.Name == "" {
 		c.Name = "executor"
 	}
+	if c.ChannelBufferMultiplier == 0 {
+		c.ChannelBufferMultiplier = defaultChannelBufferMultiplier
+	}
 	if c.ErrorPolicy == nil {
 		c.ErrorPolicy = AlwaysContinue[T]()
 	}
@@ -61,6 +149,6 @@ func (c *Config[T]) SetDefaults() {
 		c.PanicPolicy = PanicAsAbort[T]()
 	}
 	if c.MaxErrorSamples == 0 {
-		c.MaxErrorSamples = 100
+		c.MaxErrorSamples = defaultMaxErrorSamples
 	}
 }
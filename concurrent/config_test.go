@@ -20,6 +20,15 @@ func TestConfig_Validate(t *testing.T) {
 		{"negative max retry", Config[int]{Concurrency: 1, MaxRetry: -1}, true},
 		{"negative timeout", Config[int]{Concurrency: 1, Timeout: -1}, true},
 		{"valid with timeout", Config[int]{Concurrency: 1, Timeout: time.Second}, false},
+		{"negative timeout grace", Config[int]{Concurrency: 1, TimeoutGrace: -1}, true},
+		{"negative abort failure rate", Config[int]{Concurrency: 1, AbortFailureRate: -0.1}, true},
+		{"abort failure rate above one", Config[int]{Concurrency: 1, AbortFailureRate: 1.5}, true},
+		{"negative abort min samples", Config[int]{Concurrency: 1, AbortMinSamples: -1}, true},
+		{"sample last", Config[int]{Concurrency: 1, ErrorSampleStrategy: SampleLast}, false},
+		{"unknown sample strategy", Config[int]{Concurrency: 1, ErrorSampleStrategy: 7}, true},
+		{"buffer multiplier", Config[int]{Concurrency: 1, ChannelBufferMultiplier: 8}, false},
+		{"negative hedge after", Config[int]{Concurrency: 1, HedgeAfter: -1}, true},
+		{"negative buffer multiplier", Config[int]{Concurrency: 1, ChannelBufferMultiplier: -1}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -40,6 +49,7 @@ func TestConfig_SetDefaults(t *testing.T) {
 	assert.NotNil(t, config.ErrorPolicy)
 	assert.NotNil(t, config.PanicPolicy)
 	assert.Equal(t, 100, config.MaxErrorSamples)
+	assert.Equal(t, 2, config.ChannelBufferMultiplier)
 }
 
 func TestConfig_Callbacks(t *testing.T) {
@@ -4,23 +4,54 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"sync"
 	"sync/atomic"
 	"time"
 )
 
 const (
-	workChannelBufferMultiplier = 2
+	defaultChannelBufferMultiplier = 2
 )
 
-// ErrExecutorReused is returned when Run or RunStream is called more than once.
-var ErrExecutorReused = errors.New("executor already used")
+var (
+	// ErrExecutorReused is returned when Run or RunStream is called more than once.
+	ErrExecutorReused = errors.New("executor already used")
+	// ErrTaskAbandoned is returned for tasks still running after Config.Timeout plus Config.TimeoutGrace.
+	ErrTaskAbandoned = errors.New("task abandoned")
+	// ErrFailureRateExceeded is recorded as the abort error when Config.AbortFailureRate trips.
+	ErrFailureRateExceeded = errors.New("failure rate exceeded")
+
+	// errItemSkipped reports to onItemDone that Config.SkipFunc skipped an item.
+	errItemSkipped = errors.New("item skipped")
+)
+
+// taskIDKey carries the id of the item a handler invocation belongs to.
+type taskIDKey struct{}
+
+// nameKey carries Config.Name into hook and handler contexts.
+type nameKey struct{}
+
+// NameFromContext returns the Config.Name of the executor whose hooks or
+// handler received ctx.
+func NameFromContext(ctx context.Context) (string, bool) {
+	name, ok := ctx.Value(nameKey{}).(string)
+	return name, ok
+}
 
 type execCounters struct {
 	success   atomic.Int64
 	failed    atomic.Int64
 	retried   atomic.Int64
 	cancelled atomic.Int64
+	skipped   atomic.Int64
+
+	hookPanics atomic.Int64
+
+	hedged atomic.Int64
+
+	// idle accumulates, in nanoseconds, the time workers waited for work.
+	idle atomic.Int64
 }
 
 type errorCounter struct {
@@ -40,11 +71,23 @@ type Executor[T any] struct {
 	abortOnce sync.Once
 	abortInfo atomic.Pointer[AbortReason]
 
+	abortMu      sync.Mutex
+	abortReasons []*AbortReason
+
 	errorCounts sync.Map
 	sampleMu    sync.Mutex
 	samples     []ErrorSample
+	sampleNext  int
+
+	historyMu sync.Mutex
+	history   map[int][]error
 
 	used atomic.Bool
+
+	// onItemDone, when set, observes the final outcome of every item a
+	// worker picked up: nil on success, errItemSkipped when SkipFunc skipped
+	// it, otherwise the last error.
+	onItemDone func(id int, err error)
 }
 
 // New validates config, applies defaults, and returns a single-use executor.
@@ -71,23 +114,22 @@ func (e *Executor[T]) Run(ctx context.Context, items []T, handler Handler[T]) (*
 	start := time.Now()
 
 	result := &Result{
+		Name:      e.config.Name,
 		Total:     len(items),
 		StartTime: start,
 	}
 
-	ctx, cancel := context.WithCancel(ctx)
+	ctx, cancel := context.WithCancel(context.WithValue(ctx, nameKey{}, e.config.Name))
 	defer cancel()
 
-	if e.config.OnBegin != nil {
-		e.config.OnBegin(ctx, len(items))
-	}
+	e.begin(ctx, len(items))
 
 	if len(items) == 0 {
 		e.populateResult(ctx, result)
 		return result, nil
 	}
 
-	workCh := make(chan workItem[T], e.config.Concurrency*workChannelBufferMultiplier)
+	workCh := make(chan workItem[T], e.config.Concurrency*e.config.ChannelBufferMultiplier)
 	var wg sync.WaitGroup
 
 	wg.Add(1)
@@ -124,6 +166,15 @@ func (e *Executor[T]) RunStream(
 	if !e.used.CompareAndSwap(false, true) {
 		return nil, ErrExecutorReused
 	}
+	return e.runStream(ctx, in, handler)
+}
+
+// runStream is RunStream for callers that have already claimed e.
+func (e *Executor[T]) runStream(
+	ctx context.Context,
+	in <-chan T,
+	handler Handler[T],
+) (*Result, error) {
 	if ctx == nil {
 		ctx = context.Background()
 	}
@@ -131,17 +182,16 @@ func (e *Executor[T]) RunStream(
 	start := time.Now()
 
 	result := &Result{
+		Name:      e.config.Name,
 		StartTime: start,
 	}
 
-	ctx, cancel := context.WithCancel(ctx)
+	ctx, cancel := context.WithCancel(context.WithValue(ctx, nameKey{}, e.config.Name))
 	defer cancel()
 
-	if e.config.OnBegin != nil {
-		e.config.OnBegin(ctx, 0)
-	}
+	e.begin(ctx, 0)
 
-	workCh := make(chan workItem[T], e.config.Concurrency*workChannelBufferMultiplier)
+	workCh := make(chan workItem[T], e.config.Concurrency*e.config.ChannelBufferMultiplier)
 	var wg sync.WaitGroup
 	var count atomic.Int64
 
@@ -183,18 +233,41 @@ func (e *Executor[T]) RunStream(
 	return result, nil
 }
 
+func (e *Executor[T]) begin(ctx context.Context, total int) {
+	if e.config.Logger != nil {
+		e.config.Logger.LogAttrs(ctx, slog.LevelInfo, "executor started",
+			slog.String("name", e.config.Name),
+			slog.Int("total", total),
+			slog.Int("concurrency", e.config.Concurrency),
+		)
+	}
+	if e.config.OnBegin != nil {
+		e.callHook(ctx, "OnBegin", func() { e.config.OnBegin(ctx, total) })
+	}
+}
+
 func (e *Executor[T]) populateResult(ctx context.Context, result *Result) {
 	result.Success = int(e.counters.success.Load())
 	result.Failed = int(e.counters.failed.Load())
 	result.Retried = int(e.counters.retried.Load())
 	result.Cancelled = int(e.counters.cancelled.Load())
+	result.Skipped = int(e.counters.skipped.Load())
+	result.HookPanics = int(e.counters.hookPanics.Load())
+	result.Hedged = int(e.counters.hedged.Load())
+	result.WorkerIdle = time.Duration(e.counters.idle.Load())
 
 	if info := e.abortInfo.Load(); info != nil {
 		result.Aborted = true
 		result.AbortReason = info
 	}
-
-	result.ErrorSamples = e.samples
+	e.abortMu.Lock()
+	result.AbortReasons = e.abortReasons
+	e.abortMu.Unlock()
+
+	e.sampleMu.Lock()
+	// Once a SampleLast ring has wrapped, sampleNext points at the oldest sample.
+	result.ErrorSamples = append(e.samples[e.sampleNext:len(e.samples):len(e.samples)], e.samples[:e.sampleNext]...)
+	e.sampleMu.Unlock()
 	if result.ErrorCount == nil {
 		result.ErrorCount = make(map[string]int)
 	}
@@ -212,13 +285,55 @@ func (e *Executor[T]) populateResult(ctx context.Context, result *Result) {
 		return true
 	})
 
+	if e.config.RecordRetryHistory {
+		result.RetryHistory = e.history
+		if result.RetryHistory == nil {
+			result.RetryHistory = make(map[int][]error)
+		}
+	}
+
 	result.EndTime = time.Now()
 
-	if e.config.OnEnd != nil {
-		e.config.OnEnd(ctx, result)
+	if e.config.Logger != nil {
+		e.config.Logger.LogAttrs(ctx, slog.LevelInfo, "executor finished",
+			slog.String("name", result.Name),
+			slog.Int("total", result.Total),
+			slog.Int("success", result.Success),
+			slog.Int("failed", result.Failed),
+			slog.Int("cancelled", result.Cancelled),
+			slog.Int("skipped", result.Skipped),
+			slog.Float64("success_rate", result.SuccessRate()),
+			slog.Duration("duration", result.Duration()),
+			slog.Bool("aborted", result.Aborted),
+		)
+	}
+
+	if e.config.OnEnd != nil && e.callHook(ctx, "OnEnd", func() { e.config.OnEnd(ctx, result) }) {
+		result.HookPanics++
 	}
 }
 
+// callHook runs a lifecycle hook, recovering a panic so it cannot crash the
+// worker. It reports whether the hook panicked.
+func (e *Executor[T]) callHook(ctx context.Context, name string, fn func()) (panicked bool) {
+	defer func() {
+		if p := recover(); p != nil {
+			panicked = true
+			e.counters.hookPanics.Add(1)
+			if e.config.Logger != nil {
+				e.config.Logger.LogAttrs(ctx, slog.LevelWarn, "hook panicked",
+					slog.String("name", e.config.Name),
+					slog.String("hook", name),
+					slog.Any("panic", p),
+				)
+			}
+		}
+	}()
+
+	fn()
+	return false
+}
+
 func (e *Executor[T]) worker(
 	ctx context.Context,
 	workCh <-chan workItem[T],
@@ -228,8 +343,35 @@ func (e *Executor[T]) worker(
 ) {
 	defer wg.Done()
 
-	for item := range workCh {
-		e.runWithRetry(ctx, item, handler, cancel)
+	var idle time.Duration
+	defer func() {
+		e.counters.idle.Add(int64(idle))
+	}()
+
+	for {
+		waitStart := time.Now()
+		item, ok := <-workCh
+		idle += time.Since(waitStart)
+		if !ok {
+			return
+		}
+
+		var err error
+		switch {
+		case ctx.Err() != nil:
+			// Items drained after a cancel or abort never ran, so they are
+			// cancelled rather than offered to SkipFunc.
+			e.counters.cancelled.Add(1)
+			err = ctx.Err()
+		case e.config.SkipFunc != nil && e.config.SkipFunc(item.data):
+			e.counters.skipped.Add(1)
+			err = errItemSkipped
+		default:
+			err = e.runWithRetry(ctx, item, handler, cancel)
+		}
+		if e.onItemDone != nil {
+			e.onItemDone(item.id, err)
+		}
 	}
 }
 
@@ -238,19 +380,19 @@ func (e *Executor[T]) runWithRetry(
 	item workItem[T],
 	handler Handler[T],
 	cancel context.CancelFunc,
-) {
+) error {
 	for {
 		select {
 		case <-ctx.Done():
 			e.counters.cancelled.Add(1)
-			return
+			return ctx.Err()
 		default:
 		}
 
 		start := time.Now()
 
 		if e.config.OnBefore != nil {
-			e.config.OnBefore(ctx, item.data, item.attempt)
+			e.callHook(ctx, "OnBefore", func() { e.config.OnBefore(ctx, item.data, item.attempt) })
 		}
 
 		err := e.execute(ctx, item, handler, cancel)
@@ -258,21 +400,29 @@ func (e *Executor[T]) runWithRetry(
 		elapsed := time.Since(start)
 
 		if e.config.OnAfter != nil {
-			e.config.OnAfter(ctx, item.data, err, elapsed)
+			e.callHook(ctx, "OnAfter", func() { e.config.OnAfter(ctx, item.data, err, elapsed) })
 		}
 
 		if err == nil {
 			e.counters.success.Add(1)
-			return
+			return nil
 		}
 
+		if e.config.Logger != nil {
+			e.config.Logger.LogAttrs(ctx, slog.LevelWarn, "task failed",
+				slog.String("name", e.config.Name),
+				slog.Int("task_id", item.id),
+				slog.Int("attempt", item.attempt),
+				slog.Any("error", err),
+			)
+		}
 		if e.config.OnError != nil {
-			e.config.OnError(ctx, item.data, err, item.attempt)
+			e.callHook(ctx, "OnError", func() { e.config.OnError(ctx, item.data, err, item.attempt) })
 		}
 
 		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
 			e.counters.cancelled.Add(1)
-			return
+			return err
 		}
 
 		e.recordError(item, err)
@@ -282,8 +432,8 @@ func (e *Executor[T]) runWithRetry(
 		switch action {
 		case ActionRetry:
 			if item.attempt >= e.config.MaxRetry {
-				e.counters.failed.Add(1)
-				return
+				e.fail(item, cancel)
+				return err
 			}
 			e.counters.retried.Add(1)
 			item.attempt++
@@ -300,7 +450,7 @@ func (e *Executor[T]) runWithRetry(
 						}
 					}
 					e.counters.cancelled.Add(1)
-					return
+					return ctx.Err()
 				}
 			}
 
@@ -308,34 +458,138 @@ func (e *Executor[T]) runWithRetry(
 			e.counters.failed.Add(1)
 			e.abort(item, err)
 			cancel()
-			return
+			return err
 
 		default:
-			e.counters.failed.Add(1)
-			return
+			e.fail(item, cancel)
+			return err
 		}
 	}
 }
 
+// fail counts item as failed and aborts the run when the observed failure
+// rate crosses Config.AbortFailureRate.
+func (e *Executor[T]) fail(item workItem[T], cancel context.CancelFunc) {
+	failed := e.counters.failed.Add(1)
+	if e.config.AbortFailureRate <= 0 {
+		return
+	}
+
+	finished := failed + e.counters.success.Load()
+	if finished < int64(e.config.AbortMinSamples) {
+		return
+	}
+	rate := float64(failed) / float64(finished)
+	if rate > e.config.AbortFailureRate {
+		e.abort(item, fmt.Errorf("%w: %.2f > %.2f", ErrFailureRateExceeded, rate, e.config.AbortFailureRate))
+		cancel()
+	}
+}
+
 func (e *Executor[T]) execute(
 	ctx context.Context,
 	item workItem[T],
 	handler Handler[T],
 	ctxCancel context.CancelFunc,
 ) (err error) {
+	timeout := e.config.Timeout
+	if e.config.TimeoutFunc != nil {
+		timeout = e.config.TimeoutFunc(item.data)
+		if timeout < 0 {
+			return fmt.Errorf("timeout func returned negative timeout %v", timeout)
+		}
+	}
+
 	taskCtx := ctx
 	var taskCancel context.CancelFunc
 
-	if e.config.Timeout > 0 {
-		taskCtx, taskCancel = context.WithTimeout(ctx, e.config.Timeout)
+	if timeout > 0 {
+		taskCtx, taskCancel = context.WithTimeout(ctx, timeout)
 		defer func() {
 			taskCancel()
 			if errors.Is(err, context.DeadlineExceeded) {
-				err = fmt.Errorf("task timeout after %v: %w", e.config.Timeout, err)
+				err = fmt.Errorf("task timeout after %v: %w", timeout, err)
 			}
 		}()
 	}
 
+	call := func(ctx context.Context) error {
+		return e.invoke(ctx, item, handler, ctxCancel)
+	}
+	if timeout > 0 && e.config.TimeoutGrace > 0 {
+		call = func(ctx context.Context) error {
+			return e.invokeWithDeadline(ctx, item, handler, ctxCancel, timeout+e.config.TimeoutGrace)
+		}
+	}
+
+	if e.config.HedgeAfter > 0 {
+		return e.invokeHedged(taskCtx, call)
+	}
+	return call(taskCtx)
+}
+
+// invokeHedged runs call and, if it has not returned after Config.HedgeAfter,
+// runs a second copy alongside it. The first copy to return wins and the
+// other one's context is canceled.
+func (e *Executor[T]) invokeHedged(ctx context.Context, call func(context.Context) error) error {
+	done := make(chan error, 2)
+
+	primaryCtx, cancelPrimary := context.WithCancel(ctx)
+	defer cancelPrimary()
+	go func() {
+		done <- call(primaryCtx)
+	}()
+
+	timer := time.NewTimer(e.config.HedgeAfter)
+	defer timer.Stop()
+
+	select {
+	case err := <-done:
+		return err
+	case <-timer.C:
+	}
+
+	e.counters.hedged.Add(1)
+	hedgeCtx, cancelHedge := context.WithCancel(ctx)
+	defer cancelHedge()
+	go func() {
+		done <- call(hedgeCtx)
+	}()
+
+	return <-done
+}
+
+// invokeWithDeadline runs the handler in its own goroutine and stops waiting
+// for it once the hard deadline passes.
+func (e *Executor[T]) invokeWithDeadline(
+	ctx context.Context,
+	item workItem[T],
+	handler Handler[T],
+	ctxCancel context.CancelFunc,
+	hardTimeout time.Duration,
+) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- e.invoke(ctx, item, handler, ctxCancel)
+	}()
+
+	timer := time.NewTimer(hardTimeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-done:
+		return err
+	case <-timer.C:
+		return fmt.Errorf("%w: still running after %v", ErrTaskAbandoned, hardTimeout)
+	}
+}
+
+func (e *Executor[T]) invoke(
+	ctx context.Context,
+	item workItem[T],
+	handler Handler[T],
+	ctxCancel context.CancelFunc,
+) (err error) {
 	defer func() {
 		if p := recover(); p != nil {
 			err = fmt.Errorf("panic recovered: %v", p)
@@ -346,37 +600,62 @@ func (e *Executor[T]) execute(
 		}
 	}()
 
-	return handler(taskCtx, item.data)
+	return handler(context.WithValue(ctx, taskIDKey{}, item.id), item.data)
 }
 
 func (e *Executor[T]) abort(item workItem[T], err error) {
+	reason := &AbortReason{
+		TaskID:  item.id,
+		Attempt: item.attempt,
+		Error:   err,
+		Time:    time.Now(),
+	}
+	if e.config.RecordAllAborts {
+		// Holding abortMu across abortOnce keeps AbortReasons[0] equal to
+		// the stored first reason.
+		e.abortMu.Lock()
+		defer e.abortMu.Unlock()
+		e.abortReasons = append(e.abortReasons, reason)
+	}
 	e.abortOnce.Do(func() {
-		e.abortInfo.Store(&AbortReason{
-			TaskID:  item.id,
-			Attempt: item.attempt,
-			Error:   err,
-			Time:    time.Now(),
-		})
+		e.abortInfo.Store(reason)
 	})
 }
 
 func (e *Executor[T]) recordError(item workItem[T], err error) {
 	if e.config.ErrorAggregation {
 		key := err.Error()
+		if e.config.ErrorKeyFunc != nil {
+			key = e.config.ErrorKeyFunc(err)
+		}
 		v, _ := e.errorCounts.LoadOrStore(key, &errorCounter{})
 		v.(*errorCounter).count.Add(1)
 	}
 
 	if e.config.MaxErrorSamples > 0 {
+		sample := ErrorSample{
+			Error:     err,
+			TaskID:    item.id,
+			Attempt:   item.attempt,
+			Timestamp: time.Now(),
+		}
 		e.sampleMu.Lock()
-		if len(e.samples) < e.config.MaxErrorSamples {
-			e.samples = append(e.samples, ErrorSample{
-				Error:     err,
-				TaskID:    item.id,
-				Attempt:   item.attempt,
-				Timestamp: time.Now(),
-			})
+		switch {
+		case len(e.samples) < e.config.MaxErrorSamples:
+			e.samples = append(e.samples, sample)
+		case e.config.ErrorSampleStrategy == SampleLast:
+			e.samples[e.sampleNext] = sample
+			e.sampleNext = (e.sampleNext + 1) % len(e.samples)
 		}
 		e.sampleMu.Unlock()
 	}
+
+	if e.config.RecordRetryHistory {
+		e.historyMu.Lock()
+		if e.history == nil {
+			e.history = make(map[int][]error)
+		}
+		e.history[item.id] = append(e.history[item.id], err)
+		e.historyMu.Unlock()
+	}
 }
@@ -3,6 +3,9 @@ package concurrent
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -243,6 +246,49 @@ func BenchmarkExecutor_Run(b *testing.B) {
 	}
 }
 
+func BenchmarkExecutor_RunStream_BufferMultiplier(b *testing.B) {
+	for _, multiplier := range []int{1, 2, 8} {
+		b.Run(fmt.Sprintf("x%d", multiplier), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				exec, _ := New(Config[int]{Concurrency: 4, ChannelBufferMultiplier: multiplier})
+				in := make(chan int)
+				go func() {
+					defer close(in)
+					for n := range 1000 {
+						in <- n
+					}
+				}()
+				_, _ = exec.RunStream(context.Background(), in, func(context.Context, int) error {
+					return nil
+				})
+			}
+		})
+	}
+}
+
+func TestExecutor_RunStream_WorkerIdle(t *testing.T) {
+	exec, err := New(Config[int]{Concurrency: 2, ChannelBufferMultiplier: 1})
+	require.NoError(t, err)
+
+	in := make(chan int)
+	go func() {
+		defer close(in)
+		for n := range 3 {
+			time.Sleep(20 * time.Millisecond)
+			in <- n
+		}
+	}()
+
+	result, err := exec.RunStream(context.Background(), in, func(context.Context, int) error {
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Success)
+	// Both workers wait for the slow producer for most of the run.
+	assert.Greater(t, result.WorkerIdle, 60*time.Millisecond)
+	assert.LessOrEqual(t, result.WorkerIdle, 2*result.Duration())
+}
+
 func TestExecutor_Run_RetrySuccess(t *testing.T) {
 	var attempts atomic.Int64
 	exec, err := New(Config[int]{
@@ -266,6 +312,52 @@ func TestExecutor_Run_RetrySuccess(t *testing.T) {
 	assert.Equal(t, int64(2), attempts.Load())
 }
 
+func TestExecutor_Run_RecordRetryHistory(t *testing.T) {
+	errTimeout := errors.New("timeout")
+	errRefused := errors.New("connection refused")
+	var attempts atomic.Int64
+	exec, err := New(Config[int]{
+		Concurrency:        1,
+		MaxRetry:           2,
+		ErrorPolicy:        AlwaysRetry[int](),
+		RecordRetryHistory: true,
+	})
+	require.NoError(t, err)
+
+	result, err := exec.Run(context.Background(), []int{1, 2}, func(_ context.Context, item int) error {
+		if item == 2 {
+			return nil
+		}
+		switch attempts.Add(1) {
+		case 1:
+			return errTimeout
+		case 2:
+			return errRefused
+		}
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Success)
+	assert.Equal(t, map[int][]error{0: {errTimeout, errRefused}}, result.RetryHistory)
+}
+
+func TestExecutor_Run_RetryHistoryDisabled(t *testing.T) {
+	exec, err := New(Config[int]{
+		Concurrency: 1,
+		MaxRetry:    1,
+		ErrorPolicy: AlwaysRetry[int](),
+	})
+	require.NoError(t, err)
+
+	result, err := exec.Run(context.Background(), []int{1}, func(context.Context, int) error {
+		return errors.New("boom")
+	})
+
+	require.NoError(t, err)
+	assert.Nil(t, result.RetryHistory)
+}
+
 func TestExecutor_Run_ContextCancellationDuringBackoff(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	exec, err := New(Config[int]{
@@ -288,3 +380,555 @@ func TestExecutor_Run_ContextCancellationDuringBackoff(t *testing.T) {
 	assert.Equal(t, 1, result.Retried)
 	assert.True(t, result.IsComplete())
 }
+
+func TestExecutor_Run_AbortFailureRate(t *testing.T) {
+	exec, err := New(Config[int]{
+		Concurrency:      1,
+		AbortFailureRate: 0.5,
+		AbortMinSamples:  20,
+	})
+	require.NoError(t, err)
+
+	items := make([]int, 100)
+	for i := range items {
+		items[i] = i
+	}
+	var calls atomic.Int64
+	result, err := exec.Run(context.Background(), items, func(_ context.Context, item int) error {
+		calls.Add(1)
+		if item%4 == 0 {
+			return nil
+		}
+		return errors.New("downstream unavailable")
+	})
+
+	require.NoError(t, err)
+	require.True(t, result.Aborted)
+	require.NotNil(t, result.AbortReason)
+	assert.ErrorIs(t, result.AbortReason.Error, ErrFailureRateExceeded)
+	assert.Equal(t, 19, result.AbortReason.TaskID)
+	assert.Equal(t, int64(20), calls.Load())
+}
+
+func TestExecutor_Run_AbortFailureRateBelowThreshold(t *testing.T) {
+	exec, err := New(Config[int]{
+		Concurrency:      2,
+		AbortFailureRate: 0.5,
+		AbortMinSamples:  10,
+	})
+	require.NoError(t, err)
+
+	items := make([]int, 40)
+	for i := range items {
+		items[i] = i
+	}
+	result, err := exec.Run(context.Background(), items, func(_ context.Context, item int) error {
+		if item%4 == 0 {
+			return errors.New("occasional")
+		}
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.False(t, result.Aborted)
+	assert.Equal(t, 10, result.Failed)
+	assert.Equal(t, 30, result.Success)
+}
+
+func TestExecutor_Run_TimeoutGraceAbandonsUncooperativeHandler(t *testing.T) {
+	exec, err := New(Config[int]{
+		Concurrency:  1,
+		Timeout:      10 * time.Millisecond,
+		TimeoutGrace: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	release := make(chan struct{})
+	defer close(release)
+
+	done := make(chan *Result, 1)
+	go func() {
+		result, err := exec.Run(context.Background(), []int{1, 2, 3}, func(_ context.Context, item int) error {
+			if item == 1 {
+				<-release
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		done <- result
+	}()
+
+	select {
+	case result := <-done:
+		assert.Equal(t, 1, result.Failed)
+		assert.Equal(t, 2, result.Success)
+		require.Len(t, result.ErrorSamples, 1)
+		assert.ErrorIs(t, result.ErrorSamples[0].Error, ErrTaskAbandoned)
+	case <-time.After(5 * time.Second):
+		t.Fatal("executor hung on a handler that ignores cancellation")
+	}
+}
+
+func TestExecutor_Run_TimeoutGraceCooperativeHandler(t *testing.T) {
+	exec, err := New(Config[int]{
+		Concurrency:  1,
+		Timeout:      10 * time.Millisecond,
+		TimeoutGrace: time.Second,
+	})
+	require.NoError(t, err)
+
+	result, err := exec.Run(context.Background(), []int{1}, func(ctx context.Context, _ int) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Cancelled)
+	assert.Equal(t, 0, result.Failed)
+}
+
+func TestExecutor_Run_ErrorSampleStrategy(t *testing.T) {
+	items := make([]int, 200)
+	for i := range items {
+		items[i] = i
+	}
+
+	tests := []struct {
+		name     string
+		strategy SampleStrategy
+		want     []int
+	}{
+		{"first", SampleFirst, []int{0, 1, 2, 3, 4}},
+		{"last", SampleLast, []int{195, 196, 197, 198, 199}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec, err := New(Config[int]{
+				Concurrency:         1,
+				MaxErrorSamples:     5,
+				ErrorSampleStrategy: tt.strategy,
+			})
+			require.NoError(t, err)
+
+			result, err := exec.Run(context.Background(), items, func(context.Context, int) error {
+				return errors.New("fail")
+			})
+			require.NoError(t, err)
+			assert.Equal(t, 200, result.Failed)
+
+			ids := make([]int, 0, len(result.ErrorSamples))
+			for _, sample := range result.ErrorSamples {
+				ids = append(ids, sample.TaskID)
+			}
+			assert.Equal(t, tt.want, ids)
+		})
+	}
+}
+
+func TestExecutor_Run_SampleLastPartiallyFilled(t *testing.T) {
+	exec, err := New(Config[int]{
+		Concurrency:         1,
+		MaxErrorSamples:     5,
+		ErrorSampleStrategy: SampleLast,
+	})
+	require.NoError(t, err)
+
+	result, err := exec.Run(context.Background(), []int{0, 1, 2}, func(_ context.Context, item int) error {
+		if item == 1 {
+			return nil
+		}
+		return errors.New("fail")
+	})
+	require.NoError(t, err)
+	require.Len(t, result.ErrorSamples, 2)
+	assert.Equal(t, 0, result.ErrorSamples[0].TaskID)
+	assert.Equal(t, 2, result.ErrorSamples[1].TaskID)
+}
+
+func TestExecutor_Name(t *testing.T) {
+	var begin, handler, end string
+	exec, err := New(Config[int]{
+		Name:        "import-users",
+		Concurrency: 1,
+		OnBegin: func(ctx context.Context, _ int) {
+			begin, _ = NameFromContext(ctx)
+		},
+		OnEnd: func(ctx context.Context, _ *Result) {
+			end, _ = NameFromContext(ctx)
+		},
+	})
+	require.NoError(t, err)
+
+	result, err := exec.Run(context.Background(), []int{1}, func(ctx context.Context, _ int) error {
+		handler, _ = NameFromContext(ctx)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "import-users", result.Name)
+	assert.Equal(t, "import-users", begin)
+	assert.Equal(t, "import-users", handler)
+	assert.Equal(t, "import-users", end)
+
+	in := make(chan int)
+	close(in)
+	exec, err = New(Config[int]{Concurrency: 1})
+	require.NoError(t, err)
+	result, err = exec.RunStream(context.Background(), in, func(context.Context, int) error { return nil })
+	require.NoError(t, err)
+	assert.Equal(t, "executor", result.Name)
+
+	_, ok := NameFromContext(context.Background())
+	assert.False(t, ok)
+}
+
+func TestExecutor_Run_TimeoutFunc(t *testing.T) {
+	type job struct {
+		id    int
+		large bool
+		work  time.Duration
+	}
+	items := []job{
+		{id: 0, large: true, work: 50 * time.Millisecond},
+		{id: 1, large: false, work: 50 * time.Millisecond},
+		{id: 2, large: false, work: 0},
+		{id: 3, large: true, work: 0},
+	}
+
+	exec, err := New(Config[job]{
+		Concurrency: 4,
+		Timeout:     time.Nanosecond, // overridden by TimeoutFunc
+		TimeoutFunc: func(j job) time.Duration {
+			if j.large {
+				return 200 * time.Millisecond
+			}
+			return 10 * time.Millisecond
+		},
+	})
+	require.NoError(t, err)
+
+	result, err := exec.Run(context.Background(), items, func(ctx context.Context, j job) error {
+		select {
+		case <-time.After(j.work):
+			return nil
+		case <-ctx.Done():
+			return fmt.Errorf("job %d: %w", j.id, ctx.Err())
+		}
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Success)
+	assert.Equal(t, 1, result.Cancelled)
+}
+
+func TestExecutor_Run_TimeoutFuncZeroAndNegative(t *testing.T) {
+	exec, err := New(Config[int]{
+		Concurrency: 1,
+		Timeout:     time.Millisecond,
+		TimeoutFunc: func(item int) time.Duration {
+			return time.Duration(item)
+		},
+	})
+	require.NoError(t, err)
+
+	var hasDeadline bool
+	result, err := exec.Run(context.Background(), []int{0, -1}, func(ctx context.Context, item int) error {
+		if item == 0 {
+			_, hasDeadline = ctx.Deadline()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.False(t, hasDeadline, "zero means no timeout")
+	assert.Equal(t, 1, result.Success)
+	assert.Equal(t, 1, result.Failed)
+	require.Len(t, result.ErrorSamples, 1)
+	assert.Contains(t, result.ErrorSamples[0].Error.Error(), "negative timeout")
+}
+
+// recordingHandler is a slog.Handler that keeps every record it receives.
+type recordingHandler struct {
+	mu      sync.Mutex
+	records []slog.Record
+}
+
+func (h *recordingHandler) Enabled(context.Context, slog.Level) bool { return true }
+
+func (h *recordingHandler) Handle(_ context.Context, r slog.Record) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.records = append(h.records, r.Clone())
+	return nil
+}
+
+func (h *recordingHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+
+func (h *recordingHandler) WithGroup(string) slog.Handler { return h }
+
+func recordAttrs(r slog.Record) map[string]slog.Value {
+	attrs := make(map[string]slog.Value)
+	r.Attrs(func(a slog.Attr) bool {
+		attrs[a.Key] = a.Value
+		return true
+	})
+	return attrs
+}
+
+func TestExecutor_Run_Logger(t *testing.T) {
+	logs := &recordingHandler{}
+	var onErrorCalls, onEndCalls atomic.Int64
+
+	exec, err := New(Config[int]{
+		Name:        "import",
+		Concurrency: 2,
+		Logger:      slog.New(logs),
+		OnError: func(context.Context, int, error, int) {
+			onErrorCalls.Add(1)
+		},
+		OnEnd: func(context.Context, *Result) {
+			onEndCalls.Add(1)
+		},
+	})
+	require.NoError(t, err)
+
+	result, err := exec.Run(context.Background(), []int{1, 2, 3, 4}, func(_ context.Context, item int) error {
+		if item == 3 {
+			return errors.New("bad item")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Success)
+	assert.Equal(t, int64(1), onErrorCalls.Load())
+	assert.Equal(t, int64(1), onEndCalls.Load())
+
+	require.Len(t, logs.records, 3)
+
+	begin := logs.records[0]
+	assert.Equal(t, slog.LevelInfo, begin.Level)
+	assert.Equal(t, "executor started", begin.Message)
+	attrs := recordAttrs(begin)
+	assert.Equal(t, "import", attrs["name"].String())
+	assert.Equal(t, int64(4), attrs["total"].Int64())
+	assert.Equal(t, int64(2), attrs["concurrency"].Int64())
+
+	failure := logs.records[1]
+	assert.Equal(t, slog.LevelWarn, failure.Level)
+	assert.Equal(t, "task failed", failure.Message)
+	attrs = recordAttrs(failure)
+	assert.Equal(t, int64(2), attrs["task_id"].Int64())
+	assert.Equal(t, int64(0), attrs["attempt"].Int64())
+	assert.EqualError(t, attrs["error"].Any().(error), "bad item")
+
+	end := logs.records[2]
+	assert.Equal(t, slog.LevelInfo, end.Level)
+	assert.Equal(t, "executor finished", end.Message)
+	attrs = recordAttrs(end)
+	assert.Equal(t, int64(3), attrs["success"].Int64())
+	assert.Equal(t, int64(1), attrs["failed"].Int64())
+	assert.InDelta(t, 75.0, attrs["success_rate"].Float64(), 0.001)
+	assert.Equal(t, result.Duration(), attrs["duration"].Duration())
+}
+
+func TestExecutor_Run_SkipFunc(t *testing.T) {
+	var handled, before atomic.Int64
+	exec, err := New(Config[int]{
+		Concurrency: 3,
+		SkipFunc:    func(item int) bool { return item%2 == 0 },
+		OnBefore:    func(context.Context, int, int) { before.Add(1) },
+	})
+	require.NoError(t, err)
+
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	result, err := exec.Run(context.Background(), items, func(_ context.Context, item int) error {
+		handled.Add(1)
+		if item == 9 {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, result.Skipped)
+	assert.Equal(t, 4, result.Success)
+	assert.Equal(t, 1, result.Failed)
+	assert.Equal(t, int64(5), handled.Load())
+	assert.Equal(t, int64(5), before.Load())
+	assert.True(t, result.IsComplete())
+}
+
+func TestExecutor_Run_SkipFuncAfterAbort(t *testing.T) {
+	var skipCalls atomic.Int64
+	exec, err := New(Config[int]{
+		Concurrency: 1,
+		ErrorPolicy: AbortOnError[int](),
+		SkipFunc: func(item int) bool {
+			skipCalls.Add(1)
+			return item != 0
+		},
+	})
+	require.NoError(t, err)
+
+	result, err := exec.Run(context.Background(), []int{0, 1, 2, 3, 4, 5}, func(context.Context, int) error {
+		// Give the dispatcher time to queue the items drained after the abort.
+		time.Sleep(10 * time.Millisecond)
+		return errors.New("failed")
+	})
+	require.NoError(t, err)
+
+	assert.True(t, result.Aborted)
+	assert.Equal(t, int64(1), skipCalls.Load())
+	assert.Zero(t, result.Skipped)
+	assert.Positive(t, result.Cancelled)
+}
+
+func TestExecutor_Run_HookPanics(t *testing.T) {
+	logs := &recordingHandler{}
+	exec, err := New(Config[int]{
+		Concurrency: 2,
+		Logger:      slog.New(logs),
+		OnAfter: func(_ context.Context, item int, _ error, _ time.Duration) {
+			if item%2 == 0 {
+				panic("after hook")
+			}
+		},
+		OnEnd: func(context.Context, *Result) {
+			panic("end hook")
+		},
+	})
+	require.NoError(t, err)
+
+	result, err := exec.Run(context.Background(), []int{1, 2, 3, 4}, func(context.Context, int) error {
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, result.Success)
+	assert.False(t, result.Aborted)
+	assert.Equal(t, 3, result.HookPanics)
+
+	var hooks []string
+	for _, r := range logs.records {
+		if r.Message == "hook panicked" {
+			hooks = append(hooks, recordAttrs(r)["hook"].String())
+		}
+	}
+	assert.ElementsMatch(t, []string{"OnAfter", "OnAfter", "OnEnd"}, hooks)
+}
+
+func TestExecutor_Run_RecordAllAborts(t *testing.T) {
+	for _, recordAll := range []bool{false, true} {
+		t.Run(fmt.Sprintf("recordAll=%v", recordAll), func(t *testing.T) {
+			exec, err := New(Config[int]{
+				Concurrency:     5,
+				ErrorPolicy:     AbortOnError[int](),
+				RecordAllAborts: recordAll,
+			})
+			require.NoError(t, err)
+
+			// Every handler waits until all five are running, so all of them
+			// fail before the first abort cancels the run.
+			var started sync.WaitGroup
+			started.Add(5)
+			result, err := exec.Run(context.Background(), []int{0, 1, 2, 3, 4}, func(_ context.Context, item int) error {
+				started.Done()
+				started.Wait()
+				return fmt.Errorf("item %d failed", item)
+			})
+			require.NoError(t, err)
+
+			require.True(t, result.Aborted)
+			require.NotNil(t, result.AbortReason)
+			if !recordAll {
+				assert.Nil(t, result.AbortReasons)
+				return
+			}
+
+			require.Len(t, result.AbortReasons, 5)
+			assert.Same(t, result.AbortReason, result.AbortReasons[0])
+			ids := make([]int, 0, 5)
+			for _, reason := range result.AbortReasons {
+				ids = append(ids, reason.TaskID)
+			}
+			assert.ElementsMatch(t, []int{0, 1, 2, 3, 4}, ids)
+		})
+	}
+}
+
+func TestExecutor_Run_HedgeAfter(t *testing.T) {
+	var calls atomic.Int64
+	loserCanceled := make(chan struct{})
+
+	exec, err := New(Config[int]{
+		Concurrency: 1,
+		HedgeAfter:  20 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	start := time.Now()
+	result, err := exec.Run(context.Background(), []int{1}, func(ctx context.Context, _ int) error {
+		if calls.Add(1) == 1 {
+			// The first copy stalls until it is canceled.
+			<-ctx.Done()
+			close(loserCanceled)
+			return ctx.Err()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, 1, result.Success)
+	assert.Zero(t, result.Cancelled)
+	assert.Equal(t, 1, result.Hedged)
+	assert.Equal(t, int64(2), calls.Load())
+
+	select {
+	case <-loserCanceled:
+	case <-time.After(time.Second):
+		t.Fatal("losing copy was not canceled")
+	}
+}
+
+func TestExecutor_Run_HedgeAfterNotNeeded(t *testing.T) {
+	var calls atomic.Int64
+	exec, err := New(Config[int]{Concurrency: 2, HedgeAfter: time.Second})
+	require.NoError(t, err)
+
+	result, err := exec.Run(context.Background(), []int{1, 2, 3}, func(context.Context, int) error {
+		calls.Add(1)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Success)
+	assert.Zero(t, result.Hedged)
+	assert.Equal(t, int64(3), calls.Load())
+}
+
+func TestExecutor_Run_ErrorKeyFunc(t *testing.T) {
+	errNotFound := errors.New("not found")
+	exec, err := New(Config[int]{
+		Concurrency:      3,
+		ErrorAggregation: true,
+		ErrorKeyFunc: func(err error) string {
+			if errors.Is(err, errNotFound) {
+				return "record not found"
+			}
+			return "other"
+		},
+	})
+	require.NoError(t, err)
+
+	items := make([]int, 10)
+	for i := range items {
+		items[i] = i
+	}
+	result, err := exec.Run(context.Background(), items, func(_ context.Context, item int) error {
+		if item%2 == 0 {
+			return fmt.Errorf("record %d: %w", item, errNotFound)
+		}
+		return fmt.Errorf("record %d: invalid", item)
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 10, result.Failed)
+	assert.Equal(t, map[string]int{"record not found": 5, "other": 5}, result.ErrorCount)
+}
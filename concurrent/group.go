@@ -0,0 +1,65 @@
+package concurrent
+
+import (
+	"context"
+	"sync"
+)
+
+// Go runs funcs with at most concurrency of them at a time and returns the
+// first error, like errgroup. The first failure or panic cancels the context
+// passed to the remaining funcs, and funcs not yet started are skipped. A
+// non-positive concurrency runs every func at once.
+//
+// Go returns nil when every func succeeded, and ctx.Err() when ctx ended
+// before all funcs ran and none of them failed. A nil context is treated as
+// context.Background.
+func Go(ctx context.Context, concurrency int, funcs ...func(ctx context.Context) error) error {
+	if len(funcs) == 0 {
+		return nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if concurrency <= 0 || concurrency > len(funcs) {
+		concurrency = len(funcs)
+	}
+
+	exec, err := New(Config[func(context.Context) error]{
+		Name:        "go",
+		Concurrency: concurrency,
+		ErrorPolicy: AbortOnFirstError[func(context.Context) error](),
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+	)
+	result, err := exec.Run(ctx, funcs, func(ctx context.Context, fn func(context.Context) error) error {
+		err := fn(ctx)
+		if err != nil {
+			mu.Lock()
+			if firstErr == nil {
+				firstErr = err
+			}
+			mu.Unlock()
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.AbortReason != nil {
+		return result.AbortReason.Error
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+	if result.Success < result.Total {
+		return ctx.Err()
+	}
+	return nil
+}
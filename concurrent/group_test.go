@@ -0,0 +1,86 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGo_AllSuccess(t *testing.T) {
+	var calls atomic.Int64
+	var inFlight, peak atomic.Int64
+
+	fn := func(context.Context) error {
+		n := inFlight.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		inFlight.Add(-1)
+		calls.Add(1)
+		return nil
+	}
+
+	err := Go(context.Background(), 2, fn, fn, fn, fn, fn)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), calls.Load())
+	assert.LessOrEqual(t, peak.Load(), int64(2))
+
+	assert.NoError(t, Go(context.Background(), 1))
+}
+
+func TestGo_ReturnsFirstError(t *testing.T) {
+	errBoom := errors.New("boom")
+	started := make(chan struct{})
+	var cancelled atomic.Bool
+
+	err := Go(context.Background(), 0,
+		func(ctx context.Context) error {
+			close(started)
+			<-ctx.Done()
+			cancelled.Store(true)
+			return ctx.Err()
+		},
+		func(context.Context) error {
+			<-started
+			return errBoom
+		},
+	)
+	assert.ErrorIs(t, err, errBoom)
+	assert.True(t, cancelled.Load(), "siblings must see cancellation")
+}
+
+func TestGo_Panic(t *testing.T) {
+	err := Go(context.Background(), 1, func(context.Context) error {
+		panic("bad")
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "bad")
+}
+
+func TestGo_ContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var ran atomic.Int64
+
+	err := Go(ctx, 1,
+		func(context.Context) error {
+			ran.Add(1)
+			cancel()
+			return nil
+		},
+		func(context.Context) error {
+			ran.Add(1)
+			return nil
+		},
+	)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int64(1), ran.Load())
+}
@@ -0,0 +1,33 @@
+package concurrent
+
+import "context"
+
+// ContextlessHandler adapts fn, which does not take a context, to a Handler.
+// fn cannot observe cancellation, so prefer Config.TimeoutGrace if it may
+// block for long.
+func ContextlessHandler[T any](fn func(item T) error) Handler[T] {
+	return func(_ context.Context, item T) error {
+		return fn(item)
+	}
+}
+
+// HandlerFunc is an alias of ContextlessHandler.
+func HandlerFunc[T any](fn func(item T) error) Handler[T] {
+	return ContextlessHandler(fn)
+}
+
+// Chain returns a Handler that runs handlers in order on the same item and
+// stops at the first error, which it returns. Nil handlers are skipped.
+func Chain[T any](handlers ...Handler[T]) Handler[T] {
+	return func(ctx context.Context, item T) error {
+		for _, h := range handlers {
+			if h == nil {
+				continue
+			}
+			if err := h(ctx, item); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
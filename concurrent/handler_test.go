@@ -0,0 +1,69 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextlessHandler(t *testing.T) {
+	var seen []int
+	handler := ContextlessHandler(func(item int) error {
+		seen = append(seen, item)
+		if item < 0 {
+			return errors.New("negative")
+		}
+		return nil
+	})
+
+	assert.NoError(t, handler(context.Background(), 1))
+	assert.EqualError(t, handler(context.Background(), -1), "negative")
+	assert.Equal(t, []int{1, -1}, seen)
+
+	exec, err := New(Config[int]{Concurrency: 1})
+	require.NoError(t, err)
+	result, err := exec.Run(context.Background(), []int{2, 3}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Success)
+}
+
+func TestHandlerFunc(t *testing.T) {
+	errOdd := errors.New("odd")
+	exec, err := New(Config[int]{Concurrency: 2})
+	require.NoError(t, err)
+
+	result, err := exec.Run(context.Background(), []int{1, 2, 3, 4}, HandlerFunc(func(item int) error {
+		if item%2 == 1 {
+			return errOdd
+		}
+		return nil
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Success)
+	assert.Equal(t, 2, result.Failed)
+}
+
+func TestChain(t *testing.T) {
+	errSecond := errors.New("second failed")
+	var calls []string
+
+	step := func(name string, err error) Handler[int] {
+		return func(context.Context, int) error {
+			calls = append(calls, name)
+			return err
+		}
+	}
+
+	err := Chain(step("first", nil), nil, step("second", errSecond), step("third", nil))(context.Background(), 1)
+	assert.ErrorIs(t, err, errSecond)
+	assert.Equal(t, []string{"first", "second"}, calls)
+
+	calls = nil
+	assert.NoError(t, Chain(step("a", nil), step("b", nil))(context.Background(), 1))
+	assert.Equal(t, []string{"a", "b"}, calls)
+
+	assert.NoError(t, Chain[int]()(context.Background(), 1))
+}
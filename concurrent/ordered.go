@@ -0,0 +1,190 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+type orderedOutcome[R any] struct {
+	value R
+	err   error
+}
+
+// RunOrdered processes items on e and streams handler results in input order:
+// a result is sent as soon as it and every earlier item have finished. Items
+// that fail, are cancelled, or are skipped by Config.SkipFunc produce no
+// result; skipped items are not errors.
+//
+// The error channel has a buffer of one and receives at most one error once
+// the run ends: the abort error when the run aborted, otherwise the first item
+// error in input order, otherwise ctx.Err() when items were left unprocessed.
+// Both channels are closed when the run ends.
+//
+// Completions that arrive out of order wait in a reorder buffer until their
+// predecessors finish. Dispatch stops while
+// Concurrency*Config.ChannelBufferMultiplier items are in flight or waiting to
+// be emitted, so the buffer never holds more than that many results; a slow
+// item stalls dispatch instead of growing memory. Callers must drain the
+// results channel or cancel ctx.
+//
+// e is consumed like Run and RunStream; a used executor yields
+// ErrExecutorReused on the error channel.
+func RunOrdered[T, R any](
+	ctx context.Context,
+	e *Executor[T],
+	items []T,
+	handler func(context.Context, T) (R, error),
+) (<-chan R, <-chan error) {
+	results := make(chan R)
+	errCh := make(chan error, 1)
+
+	if !e.used.CompareAndSwap(false, true) {
+		errCh <- ErrExecutorReused
+		close(results)
+		close(errCh)
+		return results, errCh
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var (
+		mu     sync.Mutex
+		values = make(map[int]R)
+		// running holds items whose outcome is not yet final. A handler
+		// abandoned under Config.TimeoutGrace can return after its item was
+		// reported; its value is dropped instead of lingering in values.
+		running  = make(map[int]struct{})
+		finished = make(map[int]orderedOutcome[R])
+		notify   = make(chan struct{}, 1)
+	)
+
+	e.onItemDone = func(id int, err error) {
+		mu.Lock()
+		v := values[id]
+		delete(values, id)
+		delete(running, id)
+		finished[id] = orderedOutcome[R]{value: v, err: err}
+		mu.Unlock()
+
+		select {
+		case notify <- struct{}{}:
+		default:
+		}
+	}
+
+	take := func(id int) (orderedOutcome[R], bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		out, ok := finished[id]
+		delete(finished, id)
+		return out, ok
+	}
+
+	runCtx, cancelRun := context.WithCancel(ctx)
+	window := make(chan struct{}, e.config.Concurrency*e.config.ChannelBufferMultiplier)
+	in := make(chan T)
+
+	go func() {
+		defer close(in)
+		for _, item := range items {
+			select {
+			case window <- struct{}{}:
+			case <-runCtx.Done():
+				return
+			}
+			select {
+			case in <- item:
+			case <-runCtx.Done():
+				return
+			}
+		}
+	}()
+
+	var (
+		result *Result
+		runErr error
+	)
+	runDone := make(chan struct{})
+
+	go func() {
+		defer close(runDone)
+		defer cancelRun()
+		result, runErr = e.runStream(runCtx, in, func(ctx context.Context, item T) error {
+			id, hasID := ctx.Value(taskIDKey{}).(int)
+			if hasID {
+				mu.Lock()
+				running[id] = struct{}{}
+				mu.Unlock()
+			}
+
+			v, err := handler(ctx, item)
+			if err != nil || !hasID {
+				return err
+			}
+			mu.Lock()
+			if _, ok := running[id]; ok {
+				values[id] = v
+			}
+			mu.Unlock()
+			return nil
+		})
+	}()
+
+	go func() {
+		defer close(errCh)
+		defer close(results)
+
+		var firstErr error
+		next := 0
+		finishedRun := false
+
+		for next < len(items) {
+			out, ok := take(next)
+			if !ok {
+				if finishedRun {
+					// Every queued item has reported; the rest never ran.
+					break
+				}
+				select {
+				case <-notify:
+				case <-runDone:
+					finishedRun = true
+				}
+				continue
+			}
+
+			switch {
+			case errors.Is(out.err, errItemSkipped):
+			case out.err != nil:
+				if firstErr == nil {
+					firstErr = fmt.Errorf("item %d: %w", next, out.err)
+				}
+			default:
+				select {
+				case results <- out.value:
+				case <-ctx.Done():
+				}
+			}
+			<-window
+			next++
+		}
+
+		<-runDone
+
+		switch {
+		case runErr != nil:
+			errCh <- runErr
+		case result.Aborted:
+			errCh <- result.AbortReason.Error
+		case firstErr != nil:
+			errCh <- firstErr
+		case ctx.Err() != nil:
+			errCh <- ctx.Err()
+		}
+	}()
+
+	return results, errCh
+}
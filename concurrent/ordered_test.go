@@ -0,0 +1,200 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func collectOrdered[R any](results <-chan R, errs <-chan error) ([]R, error) {
+	var out []R
+	for r := range results {
+		out = append(out, r)
+	}
+	return out, <-errs
+}
+
+func TestRunOrdered_PreservesInputOrder(t *testing.T) {
+	exec, err := New(Config[int]{Concurrency: 4})
+	require.NoError(t, err)
+
+	items := make([]int, 50)
+	for i := range items {
+		items[i] = i
+	}
+
+	var inFlight, maxInFlight atomic.Int64
+	results, errs := RunOrdered(context.Background(), exec, items,
+		func(_ context.Context, n int) (int, error) {
+			cur := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				prev := maxInFlight.Load()
+				if cur <= prev || maxInFlight.CompareAndSwap(prev, cur) {
+					break
+				}
+			}
+			time.Sleep(time.Duration((len(items)-n)%7) * time.Millisecond)
+			return n * 10, nil
+		})
+
+	got, err := collectOrdered(results, errs)
+	require.NoError(t, err)
+
+	want := make([]int, len(items))
+	for i := range want {
+		want[i] = i * 10
+	}
+	assert.Equal(t, want, got)
+	assert.LessOrEqual(t, maxInFlight.Load(), int64(4))
+}
+
+func TestRunOrdered_BoundsReorderBuffer(t *testing.T) {
+	exec, err := New(Config[int]{Concurrency: 2})
+	require.NoError(t, err)
+
+	items := make([]int, 20)
+	for i := range items {
+		items[i] = i
+	}
+
+	release := make(chan struct{})
+	var started atomic.Int64
+	results, errs := RunOrdered(context.Background(), exec, items,
+		func(_ context.Context, n int) (int, error) {
+			started.Add(1)
+			if n == 0 {
+				<-release
+			}
+			return n, nil
+		})
+
+	time.Sleep(50 * time.Millisecond)
+	// Item 0 blocks emission, so dispatch stops at the reorder window.
+	assert.Equal(t, int64(2*defaultChannelBufferMultiplier), started.Load())
+	close(release)
+
+	got, err := collectOrdered(results, errs)
+	require.NoError(t, err)
+	assert.Equal(t, items, got)
+}
+
+func TestRunOrdered_SkipsFailedItems(t *testing.T) {
+	exec, err := New(Config[int]{Concurrency: 3})
+	require.NoError(t, err)
+
+	errOdd := errors.New("odd")
+	results, errs := RunOrdered(context.Background(), exec, []int{0, 1, 2, 3, 4},
+		func(_ context.Context, n int) (string, error) {
+			if n%2 == 1 {
+				return "", errOdd
+			}
+			return string(rune('a' + n)), nil
+		})
+
+	got, err := collectOrdered(results, errs)
+	assert.Equal(t, []string{"a", "c", "e"}, got)
+	require.ErrorIs(t, err, errOdd)
+	assert.Contains(t, err.Error(), "item 1")
+}
+
+func TestRunOrdered_SkipFunc(t *testing.T) {
+	exec, err := New(Config[int]{
+		Concurrency: 3,
+		SkipFunc:    func(n int) bool { return n%2 == 1 },
+	})
+	require.NoError(t, err)
+
+	results, errs := RunOrdered(context.Background(), exec, []int{0, 1, 2, 3, 4},
+		func(_ context.Context, n int) (string, error) {
+			return string(rune('a' + n)), nil
+		})
+
+	got, err := collectOrdered(results, errs)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "c", "e"}, got)
+}
+
+func TestRunOrdered_Abort(t *testing.T) {
+	errFatal := errors.New("fatal")
+	exec, err := New(Config[int]{
+		Concurrency: 1,
+		ErrorPolicy: AbortOnError[int](),
+	})
+	require.NoError(t, err)
+
+	results, errs := RunOrdered(context.Background(), exec, []int{0, 1, 2, 3},
+		func(_ context.Context, n int) (int, error) {
+			if n == 2 {
+				return 0, errFatal
+			}
+			return n, nil
+		})
+
+	got, err := collectOrdered(results, errs)
+	assert.Equal(t, []int{0, 1}, got)
+	assert.ErrorIs(t, err, errFatal)
+}
+
+func TestRunOrdered_ExecutorReused(t *testing.T) {
+	exec, err := New(Config[int]{Concurrency: 1})
+	require.NoError(t, err)
+	_, err = exec.Run(context.Background(), nil, func(context.Context, int) error { return nil })
+	require.NoError(t, err)
+
+	results, errs := RunOrdered(context.Background(), exec, []int{1},
+		func(_ context.Context, n int) (int, error) { return n, nil })
+
+	got, err := collectOrdered(results, errs)
+	assert.Empty(t, got)
+	assert.ErrorIs(t, err, ErrExecutorReused)
+}
+
+func TestRunOrdered_ClaimsExecutor(t *testing.T) {
+	exec, err := New(Config[int]{Concurrency: 1})
+	require.NoError(t, err)
+
+	results, errs := RunOrdered(context.Background(), exec, []int{1},
+		func(_ context.Context, n int) (int, error) { return n, nil })
+
+	_, runErr := exec.Run(context.Background(), []int{1}, func(context.Context, int) error { return nil })
+	assert.ErrorIs(t, runErr, ErrExecutorReused)
+
+	_, errs2 := RunOrdered(context.Background(), exec, []int{1},
+		func(_ context.Context, n int) (int, error) { return n, nil })
+	assert.ErrorIs(t, <-errs2, ErrExecutorReused)
+
+	got, err := collectOrdered(results, errs)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, got)
+}
+
+func TestRunOrdered_DropsAbandonedResults(t *testing.T) {
+	exec, err := New(Config[int]{
+		Concurrency:  2,
+		Timeout:      5 * time.Millisecond,
+		TimeoutGrace: 5 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	late := make(chan struct{})
+	results, errs := RunOrdered(context.Background(), exec, []int{0, 1, 2},
+		func(_ context.Context, n int) (int, error) {
+			if n == 1 {
+				// Ignores ctx and returns long after being abandoned.
+				defer close(late)
+				time.Sleep(50 * time.Millisecond)
+			}
+			return n, nil
+		})
+
+	got, err := collectOrdered(results, errs)
+	assert.Equal(t, []int{0, 2}, got)
+	assert.ErrorIs(t, err, ErrTaskAbandoned)
+	<-late
+}
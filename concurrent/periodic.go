@@ -0,0 +1,76 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+// randInt64N returns a value in [0, n). Tests replace it for deterministic jitter.
+var randInt64N = rand.Int64N
+
+// RunPeriodicJittered runs a new executor over the items returned by produce on
+// every tick until ctx is canceled. Each tick waits interval plus a random
+// offset in [-jitter, +jitter] after the previous run finishes, so instances
+// started together drift apart. Results are reported through config.OnEnd.
+//
+// It returns ctx.Err() when ctx is canceled, or the first error from produce or
+// from constructing an executor.
+func RunPeriodicJittered[T any](
+	ctx context.Context,
+	interval, jitter time.Duration,
+	produce func(ctx context.Context) ([]T, error),
+	config Config[T],
+	handler Handler[T],
+) error {
+	if interval <= 0 {
+		return fmt.Errorf("interval must be > 0, got %v", interval)
+	}
+	if jitter < 0 || jitter > interval {
+		return fmt.Errorf("jitter must be within [0, %v], got %v", interval, jitter)
+	}
+	if produce == nil {
+		return errors.New("produce is nil")
+	}
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	timer := time.NewTimer(jitteredDelay(interval, jitter))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		items, err := produce(ctx)
+		if err != nil {
+			return fmt.Errorf("produce items: %w", err)
+		}
+
+		exec, err := New(config)
+		if err != nil {
+			return err
+		}
+		if _, err := exec.Run(ctx, items, handler); err != nil {
+			return err
+		}
+
+		timer.Reset(jitteredDelay(interval, jitter))
+	}
+}
+
+func jitteredDelay(interval, jitter time.Duration) time.Duration {
+	if jitter <= 0 {
+		return interval
+	}
+	return interval - jitter + time.Duration(randInt64N(int64(2*jitter)+1))
+}
@@ -0,0 +1,95 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJitteredDelay_Bounds(t *testing.T) {
+	interval := 100 * time.Millisecond
+	jitter := 30 * time.Millisecond
+
+	for range 1000 {
+		d := jitteredDelay(interval, jitter)
+		assert.GreaterOrEqual(t, d, interval-jitter)
+		assert.LessOrEqual(t, d, interval+jitter)
+	}
+
+	assert.Equal(t, interval, jitteredDelay(interval, 0))
+}
+
+func TestJitteredDelay_InjectedSource(t *testing.T) {
+	orig := randInt64N
+	t.Cleanup(func() { randInt64N = orig })
+
+	interval := 100 * time.Millisecond
+	jitter := 30 * time.Millisecond
+
+	randInt64N = func(int64) int64 { return 0 }
+	assert.Equal(t, interval-jitter, jitteredDelay(interval, jitter))
+
+	randInt64N = func(n int64) int64 { return n - 1 }
+	assert.Equal(t, interval+jitter, jitteredDelay(interval, jitter))
+}
+
+func TestRunPeriodicJittered(t *testing.T) {
+	orig := randInt64N
+	t.Cleanup(func() { randInt64N = orig })
+	randInt64N = func(int64) int64 { return 0 }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	interval := 30 * time.Millisecond
+	jitter := 10 * time.Millisecond
+
+	var ticks []time.Time
+	var handled atomic.Int64
+	start := time.Now()
+	err := RunPeriodicJittered(ctx, interval, jitter,
+		func(context.Context) ([]int, error) {
+			ticks = append(ticks, time.Now())
+			if len(ticks) == 3 {
+				cancel()
+			}
+			return []int{1, 2}, nil
+		},
+		Config[int]{Concurrency: 2},
+		func(context.Context, int) error {
+			handled.Add(1)
+			return nil
+		},
+	)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	require.Len(t, ticks, 3)
+	prev := start
+	for _, tick := range ticks {
+		assert.GreaterOrEqual(t, tick.Sub(prev), interval-jitter)
+		prev = tick
+	}
+	assert.Equal(t, int64(4), handled.Load())
+}
+
+func TestRunPeriodicJittered_Errors(t *testing.T) {
+	produce := func(context.Context) ([]int, error) { return nil, nil }
+	handler := func(context.Context, int) error { return nil }
+	cfg := Config[int]{Concurrency: 1}
+
+	assert.Error(t, RunPeriodicJittered(context.Background(), 0, 0, produce, cfg, handler))
+	assert.Error(t, RunPeriodicJittered(context.Background(), time.Second, 2*time.Second, produce, cfg, handler))
+	assert.Error(t, RunPeriodicJittered(context.Background(), time.Second, 0, nil, cfg, handler))
+	assert.Error(t, RunPeriodicJittered(context.Background(), time.Second, 0, produce, Config[int]{}, handler))
+
+	errProduce := errors.New("source down")
+	err := RunPeriodicJittered(context.Background(), time.Millisecond, 0,
+		func(context.Context) ([]int, error) { return nil, errProduce },
+		cfg, handler)
+	assert.ErrorIs(t, err, errProduce)
+}
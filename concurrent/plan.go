@@ -0,0 +1,49 @@
+package concurrent
+
+import "time"
+
+// Plan describes how Run would dispatch a batch, without running it.
+type Plan struct {
+	Name string
+
+	Total int
+
+	// Order lists item indexes in the order they are handed to workers.
+	Order []int
+
+	// Workers is the number of workers that would do useful work: the
+	// configured Concurrency capped at Total.
+	Workers int
+
+	MaxRetry int
+
+	// Timeout is Config.Timeout; PerItemTimeout reports whether
+	// Config.TimeoutFunc overrides it.
+	Timeout        time.Duration
+	PerItemTimeout bool
+
+	HasBackoff bool
+
+	AbortFailureRate float64
+}
+
+// Plan reports how Run would dispatch items under the executor's config. It
+// never invokes a handler or hook and does not consume the executor.
+func (e *Executor[T]) Plan(items []T) *Plan {
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+
+	return &Plan{
+		Name:             e.config.Name,
+		Total:            len(items),
+		Order:            order,
+		Workers:          min(e.config.Concurrency, len(items)),
+		MaxRetry:         e.config.MaxRetry,
+		Timeout:          e.config.Timeout,
+		PerItemTimeout:   e.config.TimeoutFunc != nil,
+		HasBackoff:       e.config.Backoff != nil,
+		AbortFailureRate: e.config.AbortFailureRate,
+	}
+}
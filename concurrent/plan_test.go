@@ -0,0 +1,60 @@
+package concurrent
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutor_Plan(t *testing.T) {
+	var called bool
+	exec, err := New(Config[string]{
+		Name:        "cleanup",
+		Concurrency: 4,
+		MaxRetry:    2,
+		Timeout:     time.Second,
+		Backoff:     ConstantBackoff(time.Millisecond),
+		OnBegin:     func(context.Context, int) { called = true },
+	})
+	require.NoError(t, err)
+
+	plan := exec.Plan([]string{"a", "b", "c"})
+	assert.Equal(t, &Plan{
+		Name:       "cleanup",
+		Total:      3,
+		Order:      []int{0, 1, 2},
+		Workers:    3,
+		MaxRetry:   2,
+		Timeout:    time.Second,
+		HasBackoff: true,
+	}, plan)
+	assert.False(t, called)
+
+	// Planning does not consume the executor.
+	result, err := exec.Run(context.Background(), []string{"a"}, func(context.Context, string) error { return nil })
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Success)
+}
+
+func TestExecutor_Plan_Defaults(t *testing.T) {
+	exec, err := New(Config[int]{
+		Concurrency: 2,
+		TimeoutFunc: func(int) time.Duration { return time.Second },
+	})
+	require.NoError(t, err)
+
+	plan := exec.Plan(nil)
+	assert.Equal(t, "executor", plan.Name)
+	assert.Zero(t, plan.Total)
+	assert.Empty(t, plan.Order)
+	assert.Zero(t, plan.Workers)
+	assert.True(t, plan.PerItemTimeout)
+	assert.False(t, plan.HasBackoff)
+
+	plan = exec.Plan(make([]int, 10))
+	assert.Equal(t, 2, plan.Workers)
+	assert.Len(t, plan.Order, 10)
+}
@@ -18,26 +18,47 @@ type AbortReason struct {
 
 // Result summarizes an executor run.
 //
-// Total, Success, Failed, Retried, Cancelled, and Aborted are populated by Run
-// or RunStream. ErrorSamples holds up to Config.MaxErrorSamples. ErrorCount is
-// always non-nil: an empty map means no aggregated counts (e.g. when
-// Config.ErrorAggregation is false). Use HasErrors to check whether any item
-// failed or the run was aborted.
+// Name is the executor's Config.Name. Total, Success, Failed, Retried,
+// Cancelled, Skipped, and Aborted are populated by Run or RunStream. Skipped
+// counts items that Config.SkipFunc marked as already done; they are not
+// included in Success. Hedged counts attempts that started a second copy
+// under Config.HedgeAfter. HookPanics counts lifecycle hooks that panicked.
+// WorkerIdle is the time all workers together spent waiting for the next item;
+// when it approaches Duration multiplied by Concurrency, the producer rather
+// than the handler limits throughput. ErrorSamples holds
+// up to Config.MaxErrorSamples. ErrorCount is always non-nil: an empty map
+// means no aggregated counts (e.g. when Config.ErrorAggregation is false). Use
+// HasErrors to check whether any item failed or the run was aborted.
 type Result struct {
+	Name string
+
 	Total     int
 	Success   int
 	Failed    int
 	Retried   int
 	Cancelled int
+	Skipped   int
+
+	Hedged     int
+	HookPanics int
+
+	WorkerIdle time.Duration
 
 	Aborted     bool
 	AbortReason *AbortReason
+	// AbortReasons lists every abort in the order they were requested when
+	// Config.RecordAllAborts is set; otherwise it is nil.
+	AbortReasons []*AbortReason
 
 	StartTime time.Time
 	EndTime   time.Time
 
 	ErrorSamples []ErrorSample
 	ErrorCount   map[string]int
+
+	// RetryHistory maps a TaskID to the errors of its failed attempts in
+	// order. It is nil unless Config.RecordRetryHistory is set.
+	RetryHistory map[int][]error
 }
 
 func (r *Result) Duration() time.Duration {
@@ -56,5 +77,63 @@ func (r *Result) SuccessRate() float64 {
 }
 
 func (r *Result) IsComplete() bool {
-	return (r.Success + r.Failed + r.Cancelled) == r.Total
+	return (r.Success + r.Failed + r.Cancelled + r.Skipped) == r.Total
+}
+
+// MergeResults combines the results of several runs, such as shards of one job,
+// into a single Result. Counters and ErrorCount are summed, ErrorSamples are
+// concatenated in argument order and capped at the default of 100 samples, and
+// the time span runs from the earliest StartTime to the latest EndTime. The
+// merged run is aborted if any input was, keeping the first AbortReason. Name
+// is kept only when every input shares it. RetryHistory is left nil because
+// TaskIDs are only unique within one run. Nil results are ignored.
+func MergeResults(results ...*Result) *Result {
+	merged := &Result{ErrorCount: make(map[string]int)}
+
+	first := true
+	for _, r := range results {
+		if r == nil {
+			continue
+		}
+
+		if first {
+			merged.Name = r.Name
+			first = false
+		} else if merged.Name != r.Name {
+			merged.Name = ""
+		}
+
+		merged.Total += r.Total
+		merged.Success += r.Success
+		merged.Failed += r.Failed
+		merged.Retried += r.Retried
+		merged.Cancelled += r.Cancelled
+		merged.Skipped += r.Skipped
+		merged.Hedged += r.Hedged
+		merged.HookPanics += r.HookPanics
+		merged.WorkerIdle += r.WorkerIdle
+
+		if r.Aborted {
+			merged.Aborted = true
+			if merged.AbortReason == nil {
+				merged.AbortReason = r.AbortReason
+			}
+		}
+		merged.AbortReasons = append(merged.AbortReasons, r.AbortReasons...)
+
+		if !r.StartTime.IsZero() && (merged.StartTime.IsZero() || r.StartTime.Before(merged.StartTime)) {
+			merged.StartTime = r.StartTime
+		}
+		if r.EndTime.After(merged.EndTime) {
+			merged.EndTime = r.EndTime
+		}
+
+		if room := defaultMaxErrorSamples - len(merged.ErrorSamples); room > 0 {
+			merged.ErrorSamples = append(merged.ErrorSamples, r.ErrorSamples[:min(room, len(r.ErrorSamples))]...)
+		}
+		for key, count := range r.ErrorCount {
+			merged.ErrorCount[key] += count
+		}
+	}
+	return merged
 }
@@ -2,6 +2,7 @@ package concurrent
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -63,6 +64,7 @@ func TestResult_IsComplete(t *testing.T) {
 		{"complete", Result{Total: 10, Success: 10, Failed: 0, Cancelled: 0}, true},
 		{"incomplete", Result{Total: 10, Success: 5, Failed: 0, Cancelled: 0}, false},
 		{"empty", Result{Total: 0, Success: 0, Failed: 0, Cancelled: 0}, true},
+		{"with skipped", Result{Total: 10, Success: 6, Failed: 1, Skipped: 3}, true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -83,3 +85,64 @@ func TestExecutor_Run_EmptyItemsInitializesErrorCount(t *testing.T) {
 	assert.NotNil(t, result.ErrorCount)
 	assert.Empty(t, result.ErrorCount)
 }
+
+func TestMergeResults(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	errA := errors.New("a")
+	reason := &AbortReason{TaskID: 3, Error: errA}
+
+	merged := MergeResults(
+		&Result{
+			Name: "sync", Total: 10, Success: 8, Failed: 2, Retried: 1,
+			StartTime: base.Add(time.Second), EndTime: base.Add(5 * time.Second),
+			ErrorSamples: []ErrorSample{{Error: errA, TaskID: 1}},
+			ErrorCount:   map[string]int{"a": 2},
+		},
+		nil,
+		&Result{
+			Name: "sync", Total: 5, Success: 3, Failed: 1, Cancelled: 1,
+			StartTime: base, EndTime: base.Add(2 * time.Second),
+			Aborted: true, AbortReason: reason,
+			ErrorSamples: []ErrorSample{{Error: errA, TaskID: 3}},
+			ErrorCount:   map[string]int{"a": 1},
+		},
+		&Result{
+			Name: "sync", Total: 5, Success: 4, Skipped: 1,
+			StartTime: base.Add(3 * time.Second), EndTime: base.Add(8 * time.Second),
+			Aborted: true, AbortReason: &AbortReason{TaskID: 9},
+			ErrorCount: map[string]int{"b": 1},
+		},
+	)
+
+	assert.Equal(t, "sync", merged.Name)
+	assert.Equal(t, 20, merged.Total)
+	assert.Equal(t, 15, merged.Success)
+	assert.Equal(t, 3, merged.Failed)
+	assert.Equal(t, 1, merged.Retried)
+	assert.Equal(t, 1, merged.Cancelled)
+	assert.Equal(t, 1, merged.Skipped)
+	assert.True(t, merged.IsComplete())
+	assert.True(t, merged.Aborted)
+	assert.Same(t, reason, merged.AbortReason)
+	assert.Equal(t, base, merged.StartTime)
+	assert.Equal(t, 8*time.Second, merged.Duration())
+	assert.InDelta(t, 75.0, merged.SuccessRate(), 0.001)
+	assert.Equal(t, map[string]int{"a": 3, "b": 1}, merged.ErrorCount)
+	require.Len(t, merged.ErrorSamples, 2)
+	assert.Equal(t, 1, merged.ErrorSamples[0].TaskID)
+	assert.Equal(t, 3, merged.ErrorSamples[1].TaskID)
+}
+
+func TestMergeResults_CapsSamplesAndMixedNames(t *testing.T) {
+	samples := make([]ErrorSample, 80)
+	merged := MergeResults(
+		&Result{Name: "eu", ErrorSamples: samples},
+		&Result{Name: "us", ErrorSamples: samples},
+	)
+	assert.Empty(t, merged.Name)
+	assert.Len(t, merged.ErrorSamples, defaultMaxErrorSamples)
+
+	empty := MergeResults()
+	assert.NotNil(t, empty.ErrorCount)
+	assert.Zero(t, empty.Total)
+}
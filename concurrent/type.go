@@ -35,6 +35,29 @@ func (a ErrorAction) String() string {
 	}
 }
 
+// SampleStrategy selects which errors Result.ErrorSamples keeps once
+// Config.MaxErrorSamples is reached.
+type SampleStrategy int
+
+const (
+	// SampleFirst keeps the earliest errors and drops later ones.
+	SampleFirst SampleStrategy = iota
+
+	// SampleLast keeps the most recent errors, overwriting the oldest sample.
+	SampleLast
+)
+
+func (s SampleStrategy) String() string {
+	switch s {
+	case SampleFirst:
+		return "First"
+	case SampleLast:
+		return "Last"
+	default:
+		return "Unknown"
+	}
+}
+
 type ErrorPolicy[T any] func(err error, item T, attempt int) ErrorAction
 
 type PanicPolicy[T any] func(panicValue any, item T, attempt int) ErrorAction
@@ -25,6 +25,12 @@ func TestErrorAction_String(t *testing.T) {
 	}
 }
 
+func TestSampleStrategy_String(t *testing.T) {
+	assert.Equal(t, "First", SampleFirst.String())
+	assert.Equal(t, "Last", SampleLast.String())
+	assert.Equal(t, "Unknown", SampleStrategy(9).String())
+}
+
 func TestHandler(t *testing.T) {
 	var called bool
 	handler := func(context.Context, int) error {
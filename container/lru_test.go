@@ -0,0 +1,77 @@
+package container
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRU_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := NewLRU[string, int](2)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+
+	// Reading "a" makes "b" the least recently used entry.
+	v, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	cache.Put("c", 3)
+	assert.Equal(t, 2, cache.Len())
+
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	v, ok = cache.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+}
+
+func TestLRU_UpdateMovesToFront(t *testing.T) {
+	cache := NewLRU[string, int](2)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("a", 10)
+	cache.Put("c", 3)
+
+	v, ok := cache.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 10, v)
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestLRU_Remove(t *testing.T) {
+	cache := NewLRU[int, string](3)
+	cache.Put(1, "one")
+
+	assert.True(t, cache.Remove(1))
+	assert.False(t, cache.Remove(1))
+	assert.Zero(t, cache.Len())
+
+	v, ok := cache.Get(1)
+	assert.False(t, ok)
+	assert.Empty(t, v)
+}
+
+func TestLRU_ConcurrentUse(t *testing.T) {
+	cache := NewLRU[int, int](10)
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range 100 {
+				cache.Put(i*100+j, j)
+				cache.Get(j)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 10, cache.Len())
+}
+
+func TestNewLRU_InvalidCapacity(t *testing.T) {
+	assert.Panics(t, func() { NewLRU[int, int](0) })
+}
@@ -0,0 +1,55 @@
+package container
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrMemoizedPanic is returned to callers that were waiting on a memoized call
+// whose function panicked.
+var ErrMemoizedPanic = errors.New("container: memoized function panicked")
+
+type memoCall[V any] struct {
+	done chan struct{}
+	val  V
+	err  error
+}
+
+// Memoize returns a function that caches the successful results of fn by key.
+// It is safe for concurrent use: concurrent calls for the same key share a
+// single invocation of fn and receive its result. Errors are not cached, so
+// the next call for that key invokes fn again. A nil fn yields a function
+// that always returns ErrNilCallback.
+func Memoize[K comparable, V any](fn func(K) (V, error)) func(K) (V, error) {
+	if fn == nil {
+		return func(K) (V, error) {
+			var zero V
+			return zero, ErrNilCallback
+		}
+	}
+
+	var calls sync.Map
+	return func(key K) (V, error) {
+		c := &memoCall[V]{done: make(chan struct{})}
+		if actual, loaded := calls.LoadOrStore(key, c); loaded {
+			existing := actual.(*memoCall[V])
+			<-existing.done
+			return existing.val, existing.err
+		}
+
+		returned := false
+		defer func() {
+			if !returned {
+				c.err = ErrMemoizedPanic
+			}
+			if c.err != nil {
+				calls.CompareAndDelete(key, c)
+			}
+			close(c.done)
+		}()
+
+		c.val, c.err = fn(key)
+		returned = true
+		return c.val, c.err
+	}
+}
@@ -0,0 +1,87 @@
+package container
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoize_ConcurrentCallsShareOneInvocation(t *testing.T) {
+	var calls atomic.Int64
+	square := Memoize(func(n int) (int, error) {
+		calls.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		return n * n, nil
+	})
+
+	var wg sync.WaitGroup
+	results := make([]int, 100)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := square(7)
+			assert.NoError(t, err)
+			results[i] = v
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int64(1), calls.Load())
+	for _, v := range results {
+		assert.Equal(t, 49, v)
+	}
+
+	v, err := square(3)
+	require.NoError(t, err)
+	assert.Equal(t, 9, v)
+	assert.Equal(t, int64(2), calls.Load())
+}
+
+func TestMemoize_ErrorsAreNotCached(t *testing.T) {
+	var calls atomic.Int64
+	lookup := Memoize(func(key string) (string, error) {
+		if calls.Add(1) == 1 {
+			return "", errors.New("temporary")
+		}
+		return "value-" + key, nil
+	})
+
+	_, err := lookup("a")
+	assert.EqualError(t, err, "temporary")
+
+	v, err := lookup("a")
+	require.NoError(t, err)
+	assert.Equal(t, "value-a", v)
+
+	v, err = lookup("a")
+	require.NoError(t, err)
+	assert.Equal(t, "value-a", v)
+	assert.Equal(t, int64(2), calls.Load())
+}
+
+func TestMemoize_PanicIsNotCached(t *testing.T) {
+	var calls atomic.Int64
+	fn := Memoize(func(int) (int, error) {
+		if calls.Add(1) == 1 {
+			panic("boom")
+		}
+		return 1, nil
+	})
+
+	assert.Panics(t, func() { _, _ = fn(1) })
+
+	v, err := fn(1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, v)
+}
+
+func TestMemoize_NilCallback(t *testing.T) {
+	_, err := Memoize[int, int](nil)(1)
+	assert.ErrorIs(t, err, ErrNilCallback)
+}
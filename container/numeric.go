@@ -0,0 +1,127 @@
+package container
+
+import "cmp"
+
+// Number is an integer or floating-point element type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Sum returns the sum of input, or zero for an empty slice. Integer sums wrap
+// on overflow.
+func Sum[N Number](input []N) N {
+	var total N
+	for _, v := range input {
+		total += v
+	}
+	return total
+}
+
+// Average returns the arithmetic mean of input as float64. It returns false
+// for an empty slice.
+func Average[N Number](input []N) (float64, bool) {
+	if len(input) == 0 {
+		return 0, false
+	}
+
+	var total float64
+	for _, v := range input {
+		total += float64(v)
+	}
+	return total / float64(len(input)), true
+}
+
+// Min returns the smallest element of input. It returns false for an empty
+// slice. When several elements are equally small, the first one wins.
+func Min[T cmp.Ordered](input []T) (T, bool) {
+	if len(input) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	result := input[0]
+	for _, v := range input[1:] {
+		if cmp.Less(v, result) {
+			result = v
+		}
+	}
+	return result, true
+}
+
+// Max returns the largest element of input. It returns false for an empty
+// slice. When several elements are equally large, the first one wins.
+func Max[T cmp.Ordered](input []T) (T, bool) {
+	if len(input) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	result := input[0]
+	for _, v := range input[1:] {
+		if cmp.Less(result, v) {
+			result = v
+		}
+	}
+	return result, true
+}
+
+// MinMax returns the smallest and largest elements of input in a single pass.
+// It returns false for an empty slice.
+func MinMax[T cmp.Ordered](input []T) (minVal, maxVal T, ok bool) {
+	if len(input) == 0 {
+		return minVal, maxVal, false
+	}
+
+	minVal, maxVal = input[0], input[0]
+	for _, v := range input[1:] {
+		if cmp.Less(v, minVal) {
+			minVal = v
+		}
+		if cmp.Less(maxVal, v) {
+			maxVal = v
+		}
+	}
+	return minVal, maxVal, true
+}
+
+// MinBy returns the element of input that less orders first. It returns false
+// for an empty slice. When several elements tie, the first one wins.
+func MinBy[T any](input []T, less func(a, b T) bool) (T, bool, error) {
+	var zero T
+	if less == nil {
+		return zero, false, ErrNilCallback
+	}
+	if len(input) == 0 {
+		return zero, false, nil
+	}
+
+	result := input[0]
+	for _, v := range input[1:] {
+		if less(v, result) {
+			result = v
+		}
+	}
+	return result, true, nil
+}
+
+// MaxBy returns the element of input that less orders last. It returns false
+// for an empty slice. When several elements tie, the first one wins.
+func MaxBy[T any](input []T, less func(a, b T) bool) (T, bool, error) {
+	var zero T
+	if less == nil {
+		return zero, false, ErrNilCallback
+	}
+	if len(input) == 0 {
+		return zero, false, nil
+	}
+
+	result := input[0]
+	for _, v := range input[1:] {
+		if less(result, v) {
+			result = v
+		}
+	}
+	return result, true, nil
+}
@@ -0,0 +1,111 @@
+package container
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSum(t *testing.T) {
+	assert.Equal(t, 10, Sum([]int{1, 2, 3, 4}))
+	assert.InDelta(t, 4.0, Sum([]float64{1.5, 2.5}), 0.0001)
+	assert.Equal(t, 0, Sum([]int{}))
+	assert.Equal(t, uint8(0), Sum[uint8](nil))
+}
+
+func TestAverage(t *testing.T) {
+	avg, ok := Average([]int{1, 2, 3, 4})
+	assert.True(t, ok)
+	assert.InDelta(t, 2.5, avg, 0.0001)
+
+	avg, ok = Average([]float32{0.5, 1.5})
+	assert.True(t, ok)
+	assert.InDelta(t, 1.0, avg, 0.0001)
+
+	avg, ok = Average([]int8{math.MaxInt8, math.MaxInt8})
+	assert.True(t, ok)
+	assert.InDelta(t, float64(math.MaxInt8), avg, 0.0001)
+
+	avg, ok = Average([]int{})
+	assert.False(t, ok)
+	assert.Zero(t, avg)
+}
+
+func TestMinMax_Ordered(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []float64
+		wantMin float64
+		wantMax float64
+		wantOK  bool
+	}{
+		{"mixed", []float64{3, -1.5, 7, 2}, -1.5, 7, true},
+		{"single", []float64{4}, 4, 4, true},
+		{"empty", []float64{}, 0, 0, false},
+		{"nil", nil, 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMin, ok := Min(tt.input)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantMin, gotMin)
+
+			gotMax, ok := Max(tt.input)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantMax, gotMax)
+		})
+	}
+
+	minInt, ok := Min([]int{5, 2, 9})
+	assert.True(t, ok)
+	assert.Equal(t, 2, minInt)
+
+	maxStr, ok := Max([]string{"b", "c", "a"})
+	assert.True(t, ok)
+	assert.Equal(t, "c", maxStr)
+}
+
+func TestMinMax(t *testing.T) {
+	minVal, maxVal, ok := MinMax([]int{4, -2, 9, 0})
+	assert.True(t, ok)
+	assert.Equal(t, -2, minVal)
+	assert.Equal(t, 9, maxVal)
+
+	minVal, maxVal, ok = MinMax([]int(nil))
+	assert.False(t, ok)
+	assert.Zero(t, minVal)
+	assert.Zero(t, maxVal)
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	type player struct {
+		name  string
+		score int
+	}
+	byScore := func(a, b player) bool { return a.score < b.score }
+	players := []player{{"ann", 3}, {"bob", 9}, {"cat", 1}, {"dan", 9}, {"eve", 1}}
+
+	lowest, ok, err := MinBy(players, byScore)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "cat", lowest.name)
+
+	highest, ok, err := MaxBy(players, byScore)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "bob", highest.name)
+
+	_, ok, err = MinBy([]player{}, byScore)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, ok, err = MaxBy(nil, byScore)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = MinBy(players, nil)
+	assert.ErrorIs(t, err, ErrNilCallback)
+	_, _, err = MaxBy(players, nil)
+	assert.ErrorIs(t, err, ErrNilCallback)
+}
@@ -0,0 +1,90 @@
+package container
+
+import "container/heap"
+
+type pqEntry[T any] struct {
+	item     T
+	priority int
+	seq      uint64
+}
+
+type pqHeap[T any] struct {
+	entries []pqEntry[T]
+	maxHeap bool
+}
+
+func (h *pqHeap[T]) Len() int { return len(h.entries) }
+
+func (h *pqHeap[T]) Less(i, j int) bool {
+	a, b := h.entries[i], h.entries[j]
+	if a.priority != b.priority {
+		if h.maxHeap {
+			return a.priority > b.priority
+		}
+		return a.priority < b.priority
+	}
+	return a.seq < b.seq
+}
+
+func (h *pqHeap[T]) Swap(i, j int) { h.entries[i], h.entries[j] = h.entries[j], h.entries[i] }
+
+func (h *pqHeap[T]) Push(x any) { h.entries = append(h.entries, x.(pqEntry[T])) }
+
+func (h *pqHeap[T]) Pop() any {
+	last := len(h.entries) - 1
+	entry := h.entries[last]
+	h.entries[last] = pqEntry[T]{}
+	h.entries = h.entries[:last]
+	return entry
+}
+
+// PriorityQueue is a heap-backed queue that pops the item with the lowest
+// priority first, or the highest when created with NewMaxPriorityQueue. Items
+// with equal priority pop in the order they were pushed. Push and Pop run in
+// O(log n). A PriorityQueue is not safe for concurrent use.
+type PriorityQueue[T any] struct {
+	heap pqHeap[T]
+	seq  uint64
+}
+
+// NewPriorityQueue returns an empty queue that pops the lowest priority first.
+func NewPriorityQueue[T any]() *PriorityQueue[T] {
+	return &PriorityQueue[T]{}
+}
+
+// NewMaxPriorityQueue returns an empty queue that pops the highest priority
+// first.
+func NewMaxPriorityQueue[T any]() *PriorityQueue[T] {
+	return &PriorityQueue[T]{heap: pqHeap[T]{maxHeap: true}}
+}
+
+// Push adds item with the given priority.
+func (q *PriorityQueue[T]) Push(item T, priority int) {
+	heap.Push(&q.heap, pqEntry[T]{item: item, priority: priority, seq: q.seq})
+	q.seq++
+}
+
+// Pop removes and returns the next item. It returns false if the queue is
+// empty.
+func (q *PriorityQueue[T]) Pop() (T, bool) {
+	if q.heap.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+	return heap.Pop(&q.heap).(pqEntry[T]).item, true
+}
+
+// Peek returns the next item without removing it. It returns false if the
+// queue is empty.
+func (q *PriorityQueue[T]) Peek() (T, bool) {
+	if q.heap.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.heap.entries[0].item, true
+}
+
+// Len returns the number of queued items.
+func (q *PriorityQueue[T]) Len() int {
+	return q.heap.Len()
+}
@@ -0,0 +1,62 @@
+package container
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func drain[T any](q *PriorityQueue[T]) []T {
+	var result []T
+	for {
+		item, ok := q.Pop()
+		if !ok {
+			return result
+		}
+		result = append(result, item)
+	}
+}
+
+func TestPriorityQueue_MinFirst(t *testing.T) {
+	q := NewPriorityQueue[string]()
+	q.Push("c", 3)
+	q.Push("a", 1)
+	q.Push("d", 4)
+	q.Push("b", 2)
+	q.Push("z", -1)
+
+	assert.Equal(t, 5, q.Len())
+	next, ok := q.Peek()
+	assert.True(t, ok)
+	assert.Equal(t, "z", next)
+	assert.Equal(t, 5, q.Len())
+
+	assert.Equal(t, []string{"z", "a", "b", "c", "d"}, drain(q))
+	assert.Zero(t, q.Len())
+}
+
+func TestPriorityQueue_MaxFirst(t *testing.T) {
+	q := NewMaxPriorityQueue[int]()
+	for _, p := range []int{5, 1, 9, 3} {
+		q.Push(p*10, p)
+	}
+	assert.Equal(t, []int{90, 50, 30, 10}, drain(q))
+}
+
+func TestPriorityQueue_EqualPrioritiesAreFIFO(t *testing.T) {
+	q := NewPriorityQueue[string]()
+	q.Push("first", 1)
+	q.Push("urgent", 0)
+	q.Push("second", 1)
+	q.Push("third", 1)
+
+	assert.Equal(t, []string{"urgent", "first", "second", "third"}, drain(q))
+}
+
+func TestPriorityQueue_Empty(t *testing.T) {
+	q := NewPriorityQueue[int]()
+	_, ok := q.Pop()
+	assert.False(t, ok)
+	_, ok = q.Peek()
+	assert.False(t, ok)
+}
@@ -0,0 +1,66 @@
+package container
+
+import (
+	"fmt"
+	"iter"
+)
+
+// RingBuffer keeps the most recent Cap values pushed into it. Push overwrites
+// the oldest value once the buffer is full. A RingBuffer is not safe for
+// concurrent use.
+type RingBuffer[T any] struct {
+	buf  []T
+	head int // index of the oldest value
+	size int
+}
+
+// NewRingBuffer returns an empty ring buffer holding at most capacity values.
+// It panics if capacity is less than 1.
+func NewRingBuffer[T any](capacity int) *RingBuffer[T] {
+	if capacity < 1 {
+		panic(fmt.Sprintf("container: ring buffer capacity must be >= 1, got %d", capacity))
+	}
+	return &RingBuffer[T]{buf: make([]T, capacity)}
+}
+
+// Push appends v in O(1), overwriting the oldest value when the buffer is full.
+func (r *RingBuffer[T]) Push(v T) {
+	if r.size < len(r.buf) {
+		r.buf[(r.head+r.size)%len(r.buf)] = v
+		r.size++
+		return
+	}
+	r.buf[r.head] = v
+	r.head = (r.head + 1) % len(r.buf)
+}
+
+// Len returns the number of values in the buffer.
+func (r *RingBuffer[T]) Len() int {
+	return r.size
+}
+
+// Cap returns the maximum number of values the buffer holds.
+func (r *RingBuffer[T]) Cap() int {
+	return len(r.buf)
+}
+
+// ToSlice returns a copy of the values from oldest to newest.
+func (r *RingBuffer[T]) ToSlice() []T {
+	result := make([]T, 0, r.size)
+	for v := range r.All() {
+		result = append(result, v)
+	}
+	return result
+}
+
+// All yields the values from oldest to newest. The buffer must not be
+// modified during iteration.
+func (r *RingBuffer[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range r.size {
+			if !yield(r.buf[(r.head+i)%len(r.buf)]) {
+				return
+			}
+		}
+	}
+}
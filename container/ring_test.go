@@ -0,0 +1,55 @@
+package container
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRingBuffer_PartialFill(t *testing.T) {
+	ring := NewRingBuffer[int](4)
+	assert.Zero(t, ring.Len())
+	assert.Equal(t, 4, ring.Cap())
+	assert.Equal(t, []int{}, ring.ToSlice())
+
+	ring.Push(1)
+	ring.Push(2)
+	assert.Equal(t, 2, ring.Len())
+	assert.Equal(t, []int{1, 2}, ring.ToSlice())
+}
+
+func TestRingBuffer_Wraparound(t *testing.T) {
+	ring := NewRingBuffer[int](3)
+	for i := 1; i <= 7; i++ {
+		ring.Push(i)
+	}
+	assert.Equal(t, 3, ring.Len())
+	assert.Equal(t, []int{5, 6, 7}, ring.ToSlice())
+
+	ring.Push(8)
+	assert.Equal(t, []int{6, 7, 8}, ring.ToSlice())
+}
+
+func TestRingBuffer_All(t *testing.T) {
+	ring := NewRingBuffer[string](2)
+	ring.Push("a")
+	ring.Push("b")
+	ring.Push("c")
+
+	var got []string
+	for v := range ring.All() {
+		got = append(got, v)
+	}
+	assert.Equal(t, []string{"b", "c"}, got)
+
+	got = nil
+	for v := range ring.All() {
+		got = append(got, v)
+		break
+	}
+	assert.Equal(t, []string{"b"}, got)
+}
+
+func TestNewRingBuffer_InvalidCapacity(t *testing.T) {
+	assert.Panics(t, func() { NewRingBuffer[int](0) })
+}
@@ -1,6 +1,7 @@
 package container
 
 import (
+	"cmp"
 	"errors"
 	"slices"
 )
@@ -117,6 +118,53 @@ func Deduplicate[T comparable](input []T) []T {
 	return result
 }
 
+// DeduplicateBy returns the elements of input whose key has not been seen
+// before, keeping the first element for each key in first-seen order.
+func DeduplicateBy[T any, K comparable](input []T, key func(T) K) ([]T, error) {
+	if key == nil {
+		return nil, ErrNilCallback
+	}
+
+	if input == nil {
+		return nil, nil
+	}
+	seen := make(map[K]struct{}, len(input))
+	result := make([]T, 0, len(input))
+	for _, item := range input {
+		k := key(item)
+		if _, exists := seen[k]; !exists {
+			seen[k] = struct{}{}
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
+
+// DeduplicateByKeepLast is like DeduplicateBy but keeps the last element for
+// each key, as ToMap does. Results stay in the order each key was first seen,
+// so a later duplicate replaces the earlier element in place.
+func DeduplicateByKeepLast[T any, K comparable](input []T, key func(T) K) ([]T, error) {
+	if key == nil {
+		return nil, ErrNilCallback
+	}
+
+	if input == nil {
+		return nil, nil
+	}
+	index := make(map[K]int, len(input))
+	result := make([]T, 0, len(input))
+	for _, item := range input {
+		k := key(item)
+		if i, exists := index[k]; exists {
+			result[i] = item
+			continue
+		}
+		index[k] = len(result)
+		result = append(result, item)
+	}
+	return result, nil
+}
+
 // ToMap returns a map keyed by keySelector. Later items overwrite earlier ones
 // when the selector returns duplicate keys.
 func ToMap[T any, K comparable](input []T, keySelector func(T) K) (map[K]T, error) {
@@ -208,3 +256,190 @@ func GroupBy[T any, K comparable](input []T, keyFunc func(T) K) (map[K][]T, erro
 	}
 	return result, nil
 }
+
+// ContainsFunc reports whether at least one element of input satisfies predicate.
+func ContainsFunc[T any](input []T, predicate func(T) bool) (bool, error) {
+	if predicate == nil {
+		return false, ErrNilCallback
+	}
+	return slices.ContainsFunc(input, predicate), nil
+}
+
+// EqualFunc reports whether s1 and s2 have the same length and eq reports
+// true for every pair of elements at the same index. Nil and empty slices
+// are equal.
+func EqualFunc[T any](s1, s2 []T, eq func(T, T) bool) (bool, error) {
+	if eq == nil {
+		return false, ErrNilCallback
+	}
+	return slices.EqualFunc(s1, s2, eq), nil
+}
+
+// MapFilter transforms each element with fn and keeps only the results for
+// which fn returns true. The input order is preserved.
+func MapFilter[T any, R any](input []T, fn func(T) (R, bool)) ([]R, error) {
+	if fn == nil {
+		return nil, ErrNilCallback
+	}
+
+	if input == nil {
+		return nil, nil
+	}
+	result := make([]R, 0, len(input))
+	for _, item := range input {
+		if v, ok := fn(item); ok {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// Sorted returns a copy of input in ascending order, leaving input unchanged.
+func Sorted[T cmp.Ordered](input []T) []T {
+	if input == nil {
+		return nil
+	}
+
+	result := slices.Clone(input)
+	slices.Sort(result)
+	return result
+}
+
+// SortedBy returns a copy of input ordered by less, leaving input unchanged.
+// The relative order of equal elements is unspecified; use SortedStableBy to
+// keep it.
+func SortedBy[T any](input []T, less func(a, b T) bool) ([]T, error) {
+	if less == nil {
+		return nil, ErrNilCallback
+	}
+
+	if input == nil {
+		return nil, nil
+	}
+	result := slices.Clone(input)
+	slices.SortFunc(result, lessToCompare(less))
+	return result, nil
+}
+
+// SortedStableBy is like SortedBy but keeps equal elements in their original
+// order.
+func SortedStableBy[T any](input []T, less func(a, b T) bool) ([]T, error) {
+	if less == nil {
+		return nil, ErrNilCallback
+	}
+
+	if input == nil {
+		return nil, nil
+	}
+	result := slices.Clone(input)
+	slices.SortStableFunc(result, lessToCompare(less))
+	return result, nil
+}
+
+func lessToCompare[T any](less func(a, b T) bool) func(a, b T) int {
+	return func(a, b T) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+// Pair holds two values produced by Zip.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip pairs the elements of a and b by index. The result stops at the shorter
+// slice, so trailing elements of the longer one are dropped. It returns nil if
+// either slice is nil.
+func Zip[A, B any](a []A, b []B) []Pair[A, B] {
+	if a == nil || b == nil {
+		return nil
+	}
+
+	n := min(len(a), len(b))
+	result := make([]Pair[A, B], n)
+	for i := range n {
+		result[i] = Pair[A, B]{First: a[i], Second: b[i]}
+	}
+	return result
+}
+
+// Unzip splits pairs into a slice of first values and a slice of second values.
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	if pairs == nil {
+		return nil, nil
+	}
+
+	as := make([]A, len(pairs))
+	bs := make([]B, len(pairs))
+	for i, p := range pairs {
+		as[i] = p.First
+		bs[i] = p.Second
+	}
+	return as, bs
+}
+
+// ZipToMap maps keys[i] to vals[i], stopping at the shorter slice like Zip.
+// Later values overwrite earlier ones when keys repeat.
+func ZipToMap[K comparable, V any](keys []K, vals []V) map[K]V {
+	n := min(len(keys), len(vals))
+	result := make(map[K]V, n)
+	for i := range n {
+		result[keys[i]] = vals[i]
+	}
+	return result
+}
+
+// Reverse returns a copy of input in reverse order.
+func Reverse[T any](input []T) []T {
+	if input == nil {
+		return nil
+	}
+
+	result := slices.Clone(input)
+	slices.Reverse(result)
+	return result
+}
+
+// Rotate returns a copy of input rotated left by n positions; a negative n
+// rotates right. n may exceed the length of input.
+func Rotate[T any](input []T, n int) []T {
+	if input == nil {
+		return nil
+	}
+
+	if len(input) == 0 {
+		return []T{}
+	}
+
+	n %= len(input)
+	if n < 0 {
+		n += len(input)
+	}
+	result := make([]T, 0, len(input))
+	result = append(result, input[n:]...)
+	return append(result, input[:n]...)
+}
+
+// Window returns every contiguous run of size elements of input, in order,
+// giving len(input)-size+1 windows. Each window is a copy, so changing one
+// does not affect input or the other windows. It returns an empty result when
+// size is less than 1 or greater than len(input).
+func Window[T any](input []T, size int) [][]T {
+	if size < 1 || size > len(input) {
+		return [][]T{}
+	}
+
+	result := make([][]T, 0, len(input)-size+1)
+	for i := 0; i+size <= len(input); i++ {
+		result = append(result, slices.Clone(input[i:i+size]))
+	}
+	return result
+}
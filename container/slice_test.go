@@ -1,6 +1,8 @@
 package container
 
 import (
+	"slices"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -78,6 +80,31 @@ func TestDeduplicate(t *testing.T) {
 	}
 }
 
+func TestDeduplicateBy(t *testing.T) {
+	type User struct {
+		ID   int
+		Name string
+	}
+	users := []User{{1, "alice"}, {2, "bob"}, {1, "alice v2"}, {3, "carol"}, {2, "bob v2"}}
+	byID := func(u User) int { return u.ID }
+
+	first, err := DeduplicateBy(users, byID)
+	require.NoError(t, err)
+	assert.Equal(t, []User{{1, "alice"}, {2, "bob"}, {3, "carol"}}, first)
+
+	last, err := DeduplicateByKeepLast(users, byID)
+	require.NoError(t, err)
+	assert.Equal(t, []User{{1, "alice v2"}, {2, "bob v2"}, {3, "carol"}}, last)
+
+	first, err = DeduplicateBy[User](nil, byID)
+	require.NoError(t, err)
+	assert.Nil(t, first)
+
+	last, err = DeduplicateByKeepLast([]User{}, byID)
+	require.NoError(t, err)
+	assert.Empty(t, last)
+}
+
 func TestToMap(t *testing.T) {
 	type Person struct{ Name string }
 	input := []Person{{Name: "Alice"}, {Name: "Bob"}}
@@ -105,6 +132,23 @@ func TestFlatMap(t *testing.T) {
 	assert.Equal(t, []string{"a", "b", "a", "b"}, result)
 }
 
+func TestMapFilter(t *testing.T) {
+	result, err := MapFilter([]int{1, 2, 3, 4, 5}, func(n int) (string, bool) {
+		return strconv.Itoa(n * 10), n%2 == 1
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10", "30", "50"}, result)
+
+	result, err = MapFilter([]int{2, 4}, func(n int) (string, bool) { return "", false })
+	require.NoError(t, err)
+	assert.Empty(t, result)
+	assert.NotNil(t, result)
+
+	result, err = MapFilter(nil, func(n int) (string, bool) { return "", true })
+	require.NoError(t, err)
+	assert.Nil(t, result)
+}
+
 func TestReduce(t *testing.T) {
 	sum, err := Reduce([]int{1, 2, 3, 4}, 0, func(acc, val int) int { return acc + val })
 	require.NoError(t, err)
@@ -148,6 +192,169 @@ func TestGroupBy_EmptyInput(t *testing.T) {
 	assert.NotNil(t, result)
 }
 
+type taggedItem struct {
+	ID   int
+	Tags []string
+}
+
+func TestContainsFunc(t *testing.T) {
+	input := []taggedItem{{ID: 1, Tags: []string{"a"}}, {ID: 2, Tags: []string{"b", "c"}}}
+
+	ok, err := ContainsFunc(input, func(item taggedItem) bool { return slices.Contains(item.Tags, "c") })
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = ContainsFunc(input, func(item taggedItem) bool { return item.ID == 3 })
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	ok, err = ContainsFunc(nil, func(taggedItem) bool { return true })
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestEqualFunc(t *testing.T) {
+	sameTags := func(a, b taggedItem) bool { return slices.Equal(a.Tags, b.Tags) }
+
+	tests := []struct {
+		name string
+		s1   []taggedItem
+		s2   []taggedItem
+		want bool
+	}{
+		{"equal ignoring ID", []taggedItem{{ID: 1, Tags: []string{"a"}}}, []taggedItem{{ID: 2, Tags: []string{"a"}}}, true},
+		{"different tags", []taggedItem{{Tags: []string{"a"}}}, []taggedItem{{Tags: []string{"b"}}}, false},
+		{"different length", []taggedItem{{}}, []taggedItem{{}, {}}, false},
+		{"nil and empty", nil, []taggedItem{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EqualFunc(tt.s1, tt.s2, sameTags)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSorted(t *testing.T) {
+	input := []int{3, 1, 2}
+	assert.Equal(t, []int{1, 2, 3}, Sorted(input))
+	assert.Equal(t, []int{3, 1, 2}, input)
+
+	assert.Nil(t, Sorted[int](nil))
+	assert.Equal(t, []string{}, Sorted([]string{}))
+}
+
+func TestSortedBy(t *testing.T) {
+	type user struct {
+		name string
+		age  int
+	}
+	input := []user{{"carol", 30}, {"alice", 25}, {"dave", 30}, {"bob", 25}}
+	original := slices.Clone(input)
+	byAge := func(a, b user) bool { return a.age < b.age }
+
+	sorted, err := SortedBy(input, byAge)
+	require.NoError(t, err)
+	assert.Equal(t, original, input)
+	require.Len(t, sorted, 4)
+	assert.True(t, slices.IsSortedFunc(sorted, lessToCompare(byAge)))
+
+	stable, err := SortedStableBy(input, byAge)
+	require.NoError(t, err)
+	assert.Equal(t, original, input)
+	assert.Equal(t, []user{{"alice", 25}, {"bob", 25}, {"carol", 30}, {"dave", 30}}, stable)
+
+	sorted, err = SortedBy[user](nil, byAge)
+	require.NoError(t, err)
+	assert.Nil(t, sorted)
+}
+
+func TestZip(t *testing.T) {
+	pairs := Zip([]int{1, 2, 3}, []string{"a", "b"})
+	assert.Equal(t, []Pair[int, string]{{1, "a"}, {2, "b"}}, pairs)
+
+	pairs = Zip([]int{1}, []string{"a", "b", "c"})
+	assert.Equal(t, []Pair[int, string]{{1, "a"}}, pairs)
+
+	assert.Empty(t, Zip([]int{}, []string{"a"}))
+	assert.Nil(t, Zip[int, string](nil, []string{"a"}))
+
+	ids, names := Unzip([]Pair[int, string]{{1, "a"}, {2, "b"}})
+	assert.Equal(t, []int{1, 2}, ids)
+	assert.Equal(t, []string{"a", "b"}, names)
+
+	ids, names = Unzip[int, string](nil)
+	assert.Nil(t, ids)
+	assert.Nil(t, names)
+}
+
+func TestZipToMap(t *testing.T) {
+	assert.Equal(t, map[string]int{"a": 1, "b": 2}, ZipToMap([]string{"a", "b", "c"}, []int{1, 2}))
+	assert.Equal(t, map[string]int{"a": 3}, ZipToMap([]string{"a", "a"}, []int{1, 3, 5}))
+	assert.Empty(t, ZipToMap[string, int](nil, nil))
+}
+
+func TestReverse(t *testing.T) {
+	input := []int{1, 2, 3}
+	assert.Equal(t, []int{3, 2, 1}, Reverse(input))
+	assert.Equal(t, []int{1, 2, 3}, input)
+	assert.Equal(t, []int{}, Reverse([]int{}))
+	assert.Nil(t, Reverse[int](nil))
+}
+
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		n     int
+		want  []int
+	}{
+		{"left", []int{1, 2, 3, 4, 5}, 2, []int{3, 4, 5, 1, 2}},
+		{"right", []int{1, 2, 3, 4, 5}, -1, []int{5, 1, 2, 3, 4}},
+		{"zero", []int{1, 2, 3}, 0, []int{1, 2, 3}},
+		{"full turn", []int{1, 2, 3}, 3, []int{1, 2, 3}},
+		{"beyond length", []int{1, 2, 3}, 7, []int{2, 3, 1}},
+		{"negative beyond length", []int{1, 2, 3}, -5, []int{2, 3, 1}},
+		{"empty", []int{}, 4, []int{}},
+		{"nil", nil, 1, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := slices.Clone(tt.input)
+			assert.Equal(t, tt.want, Rotate(tt.input, tt.n))
+			assert.Equal(t, original, tt.input)
+		})
+	}
+}
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		size  int
+		want  [][]int
+	}{
+		{"size 1", []int{1, 2, 3}, 1, [][]int{{1}, {2}, {3}}},
+		{"size 2", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {2, 3}, {3, 4}}},
+		{"size equals length", []int{1, 2, 3}, 3, [][]int{{1, 2, 3}}},
+		{"size exceeds length", []int{1, 2}, 3, [][]int{}},
+		{"zero size", []int{1, 2}, 0, [][]int{}},
+		{"nil", nil, 1, [][]int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Window(tt.input, tt.size))
+		})
+	}
+
+	input := []int{1, 2, 3}
+	windows := Window(input, 2)
+	windows[0][1] = 99
+	assert.Equal(t, []int{1, 2, 3}, input)
+	assert.Equal(t, []int{2, 3}, windows[1])
+}
+
 func TestCallbackHelpers_NilCallbacksReturnError(t *testing.T) {
 	_, err := ToMap[int, int]([]int{}, nil)
 	assert.ErrorIs(t, err, ErrNilCallback)
@@ -166,6 +373,27 @@ func TestCallbackHelpers_NilCallbacksReturnError(t *testing.T) {
 
 	_, err = GroupBy[int, int]([]int{}, nil)
 	assert.ErrorIs(t, err, ErrNilCallback)
+
+	_, err = MapFilter[int, int]([]int{}, nil)
+	assert.ErrorIs(t, err, ErrNilCallback)
+
+	_, err = ContainsFunc([]int{}, nil)
+	assert.ErrorIs(t, err, ErrNilCallback)
+
+	_, err = EqualFunc([]int{}, []int{}, nil)
+	assert.ErrorIs(t, err, ErrNilCallback)
+
+	_, err = DeduplicateBy[int, int]([]int{}, nil)
+	assert.ErrorIs(t, err, ErrNilCallback)
+
+	_, err = DeduplicateByKeepLast[int, int]([]int{}, nil)
+	assert.ErrorIs(t, err, ErrNilCallback)
+
+	_, err = SortedBy([]int{}, nil)
+	assert.ErrorIs(t, err, ErrNilCallback)
+
+	_, err = SortedStableBy([]int{}, nil)
+	assert.ErrorIs(t, err, ErrNilCallback)
 }
 
 func BenchmarkDeduplicate(b *testing.B) {
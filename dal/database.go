@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // Repository defines generic CRUD operations for a GORM-backed model.
@@ -14,34 +16,96 @@ type Repository[T any] interface {
 
 	BatchInsert(ctx context.Context, db *gorm.DB, newValues []*T, batchSize int) error
 
+	Upsert(ctx context.Context, db *gorm.DB, newValue *T, conflictColumns []string, updateColumns []string) error
+
 	Update(ctx context.Context, db *gorm.DB, newValue *T, scopes ...func(db *gorm.DB) *gorm.DB) error
 
 	UpdateFields(ctx context.Context, db *gorm.DB, newValue map[string]any, scopes ...func(db *gorm.DB) *gorm.DB) error
 
 	QueryOne(ctx context.Context, db *gorm.DB, scopes ...func(db *gorm.DB) *gorm.DB) (*T, error)
 
+	QueryOneOrNil(ctx context.Context, db *gorm.DB, scopes ...func(db *gorm.DB) *gorm.DB) (*T, error)
+
+	FindByID(ctx context.Context, db *gorm.DB, id any) (*T, error)
+
+	ExistsByID(ctx context.Context, db *gorm.DB, id any) (bool, error)
+
 	Query(ctx context.Context, db *gorm.DB, scopes ...func(db *gorm.DB) *gorm.DB) ([]T, error)
 
+	QueryInBatches(
+		ctx context.Context,
+		db *gorm.DB,
+		batchSize int,
+		fn func(batch []T) error,
+		scopes ...func(db *gorm.DB) *gorm.DB,
+	) error
+
 	Count(ctx context.Context, db *gorm.DB, scopes ...func(db *gorm.DB) *gorm.DB) (int64, error)
 
+	QueryPage(ctx context.Context, db *gorm.DB, page, pageSize int, scopes ...func(db *gorm.DB) *gorm.DB) (*Page[T], error)
+
 	Raw(ctx context.Context, db *gorm.DB, sql string, args ...any) ([]T, error)
 
 	Delete(ctx context.Context, db *gorm.DB, scopes ...func(db *gorm.DB) *gorm.DB) error
+
+	HardDelete(ctx context.Context, db *gorm.DB, scopes ...func(db *gorm.DB) *gorm.DB) error
+}
+
+type Repo[T any] struct {
+	queryTimeout time.Duration
+}
+
+// RepoOption configures a Repo created by NewRepoWithOptions.
+type RepoOption func(*repoConfig)
+
+type repoConfig struct {
+	queryTimeout time.Duration
+}
+
+// WithQueryTimeout bounds each Repo operation by d when the caller's context
+// has no deadline of its own. QueryInBatches is exempt because its iteration
+// includes the caller's callback. Non-positive values disable the default.
+func WithQueryTimeout(d time.Duration) RepoOption {
+	return func(c *repoConfig) {
+		c.queryTimeout = d
+	}
 }
 
-type Repo[T any] struct{}
+// Page is one page of query results together with the total match count.
+type Page[T any] struct {
+	Items      []T
+	Total      int64
+	Page       int
+	PageSize   int
+	TotalPages int
+}
 
 var (
 	// ErrDatabase wraps unexpected GORM operation errors.
 	ErrDatabase = errors.New("unexpected database error")
 	// ErrNoRowsAffected indicates that a write operation matched no rows.
 	ErrNoRowsAffected = errors.New("no rows affected")
+	// ErrNotFound indicates that a single-row query matched no rows.
+	ErrNotFound = errors.New("record not found")
+	// ErrMissingCondition indicates that an update or delete would affect the
+	// whole table. Pass the AllowFullTableUpdate scope to do so on purpose.
+	ErrMissingCondition = errors.New("missing where condition")
 )
 
 func NewRepo[T any]() *Repo[T] {
 	return &Repo[T]{}
 }
 
+// NewRepoWithOptions returns a Repo configured by opts. With no options it is
+// equivalent to NewRepo.
+func NewRepoWithOptions[T any](opts ...RepoOption) *Repo[T] {
+	cfg := &repoConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return &Repo[T]{queryTimeout: cfg.queryTimeout}
+}
+
 func (r *Repo[T]) Insert(ctx context.Context, db *gorm.DB, newValue *T) error {
 	if db == nil {
 		return errors.New("insert: db is nil")
@@ -49,6 +113,8 @@ func (r *Repo[T]) Insert(ctx context.Context, db *gorm.DB, newValue *T) error {
 	if newValue == nil {
 		return errors.New("insert: new value is nil")
 	}
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
 	result := db.WithContext(ctx).Create(newValue)
 	return handleExecError("insert", result)
 }
@@ -69,11 +135,53 @@ func (r *Repo[T]) BatchInsert(ctx context.Context, db *gorm.DB, newValues []*T,
 	if batchSize <= 0 {
 		batchSize = 10
 	}
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
 	result := db.WithContext(ctx).CreateInBatches(newValues, batchSize)
 	return handleExecError("batch insert", result)
 }
 
-// Update updates non-zero fields in newValue for rows matched by scopes.
+// Upsert inserts newValue, or updates the existing row when the insert
+// conflicts on conflictColumns. Only updateColumns are overwritten; when it is
+// empty, all non-primary columns are. An upsert that leaves the row unchanged
+// is not an error.
+func (r *Repo[T]) Upsert(
+	ctx context.Context,
+	db *gorm.DB,
+	newValue *T,
+	conflictColumns []string,
+	updateColumns []string,
+) error {
+	if db == nil {
+		return errors.New("upsert: db is nil")
+	}
+	if newValue == nil {
+		return errors.New("upsert: new value is nil")
+	}
+	if len(conflictColumns) == 0 {
+		return errors.New("upsert: conflict columns are required")
+	}
+
+	onConflict := clause.OnConflict{UpdateAll: len(updateColumns) == 0}
+	for _, column := range conflictColumns {
+		onConflict.Columns = append(onConflict.Columns, clause.Column{Name: column})
+	}
+	if len(updateColumns) > 0 {
+		onConflict.DoUpdates = clause.AssignmentColumns(updateColumns)
+	}
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+	result := db.WithContext(ctx).Clauses(onConflict).Create(newValue)
+	if result.Error != nil {
+		return fmt.Errorf("upsert failed: %w: %w", ErrDatabase, result.Error)
+	}
+	return nil
+}
+
+// Update updates non-zero fields in newValue for rows matched by scopes. It
+// returns ErrMissingCondition unless scopes add a WHERE condition or include
+// AllowFullTableUpdate.
 func (r *Repo[T]) Update(ctx context.Context, db *gorm.DB, newValue *T, scopes ...func(db *gorm.DB) *gorm.DB) error {
 	if db == nil {
 		return errors.New("update: db is nil")
@@ -82,13 +190,17 @@ func (r *Repo[T]) Update(ctx context.Context, db *gorm.DB, newValue *T, scopes .
 		return errors.New("update: new value is nil")
 	}
 	if len(scopes) == 0 {
-		return errors.New("update: scope is required")
+		return fmt.Errorf("update: %w", ErrMissingCondition)
 	}
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
 	result := db.WithContext(ctx).Model(new(T)).Scopes(scopes...).Updates(newValue)
 	return handleExecError("update", result)
 }
 
-// UpdateFields updates explicit fields for rows matched by scopes.
+// UpdateFields updates explicit fields for rows matched by scopes. It returns
+// ErrMissingCondition unless scopes add a WHERE condition or include
+// AllowFullTableUpdate.
 func (r *Repo[T]) UpdateFields(
 	ctx context.Context,
 	db *gorm.DB,
@@ -102,54 +214,199 @@ func (r *Repo[T]) UpdateFields(
 		return errors.New("update fields: new value is empty")
 	}
 	if len(scopes) == 0 {
-		return errors.New("update fields: scope is required")
+		return fmt.Errorf("update fields: %w", ErrMissingCondition)
 	}
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
 	result := db.WithContext(ctx).Model(new(T)).Scopes(scopes...).Updates(newValue)
 	return handleExecError("update fields", result)
 }
 
+// QueryOne returns the first row matched by scopes, ordered by primary key. It
+// returns ErrNotFound when no row matches.
 func (r *Repo[T]) QueryOne(ctx context.Context, db *gorm.DB, scopes ...func(db *gorm.DB) *gorm.DB) (*T, error) {
 	if db == nil {
 		return nil, errors.New("query one: db is nil")
 	}
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
 	var record T
 	result := db.WithContext(ctx).Scopes(scopes...).First(&record)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("query one: %w: %w", ErrNotFound, result.Error)
+	}
 	if err := handleQueryError("query one", result); err != nil {
 		return nil, err
 	}
 	return &record, nil
 }
 
+// QueryOneOrNil is like QueryOne but returns (nil, nil) when no row matches.
+func (r *Repo[T]) QueryOneOrNil(ctx context.Context, db *gorm.DB, scopes ...func(db *gorm.DB) *gorm.DB) (*T, error) {
+	record, err := r.QueryOne(ctx, db, scopes...)
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	return record, err
+}
+
+// FindByID returns the row whose primary key equals id, or ErrNotFound.
+func (r *Repo[T]) FindByID(ctx context.Context, db *gorm.DB, id any) (*T, error) {
+	if db == nil {
+		return nil, errors.New("find by id: db is nil")
+	}
+	record, err := r.QueryOne(ctx, db, primaryKeyEqual[T](id))
+	if err != nil {
+		return nil, fmt.Errorf("find by id %v: %w", id, err)
+	}
+	return record, nil
+}
+
+// ExistsByID reports whether a row with primary key id exists.
+func (r *Repo[T]) ExistsByID(ctx context.Context, db *gorm.DB, id any) (bool, error) {
+	if db == nil {
+		return false, errors.New("exists by id: db is nil")
+	}
+	count, err := r.Count(ctx, db, primaryKeyEqual[T](id))
+	if err != nil {
+		return false, fmt.Errorf("exists by id %v: %w", id, err)
+	}
+	return count > 0, nil
+}
+
 func (r *Repo[T]) Query(ctx context.Context, db *gorm.DB, scopes ...func(db *gorm.DB) *gorm.DB) ([]T, error) {
 	if db == nil {
 		return nil, errors.New("query: db is nil")
 	}
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
 	records := []T{}
 	result := db.WithContext(ctx).Scopes(scopes...).Find(&records)
 	return records, handleQueryError("query", result)
 }
 
+// QueryInBatches reads rows matched by scopes batchSize at a time, ordered by
+// primary key, and calls fn with each batch so large result sets need not fit
+// in memory. The batch slice is reused between calls; copy rows fn keeps. It
+// stops at the first error from fn and returns it unwrapped. A non-positive
+// batchSize uses DefaultPageSize.
+//
+// The repo's default query timeout is not applied, since it would also cut
+// off time spent in fn; ctx alone bounds the whole iteration.
+func (r *Repo[T]) QueryInBatches(
+	ctx context.Context,
+	db *gorm.DB,
+	batchSize int,
+	fn func(batch []T) error,
+	scopes ...func(db *gorm.DB) *gorm.DB,
+) error {
+	if db == nil {
+		return errors.New("query in batches: db is nil")
+	}
+	if fn == nil {
+		return errors.New("query in batches: fn is nil")
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultPageSize
+	}
+
+	var fnErr error
+	var batch []T
+	result := db.WithContext(ctx).Scopes(scopes...).FindInBatches(&batch, batchSize, func(*gorm.DB, int) error {
+		fnErr = fn(batch)
+		return fnErr
+	})
+	if fnErr != nil {
+		return fnErr
+	}
+	return handleQueryError("query in batches", result)
+}
+
 func (r *Repo[T]) Count(ctx context.Context, db *gorm.DB, scopes ...func(db *gorm.DB) *gorm.DB) (int64, error) {
 	if db == nil {
 		return 0, errors.New("count: db is nil")
 	}
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
 	var count int64
 	result := db.WithContext(ctx).Model(new(T)).Scopes(scopes...).Count(&count)
 	return count, handleQueryError("count", result)
 }
 
-// Delete removes rows matched by scopes. At least one scope is required.
+// QueryPage returns the 1-based page of rows matched by scopes along with the
+// total number of matches. page and pageSize are normalized as in Paginate.
+// Scopes must not paginate themselves. The count and the page are read in one
+// transaction so they agree with each other.
+func (r *Repo[T]) QueryPage(
+	ctx context.Context,
+	db *gorm.DB,
+	page, pageSize int,
+	scopes ...func(db *gorm.DB) *gorm.DB,
+) (*Page[T], error) {
+	if db == nil {
+		return nil, errors.New("query page: db is nil")
+	}
+	page, pageSize = normalizePage(page, pageSize)
+	result := &Page[T]{Items: []T{}, Page: page, PageSize: pageSize}
+
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		count := tx.Model(new(T)).Scopes(scopes...).Count(&result.Total)
+		if err := handleQueryError("query page count", count); err != nil {
+			return err
+		}
+		if result.Total == 0 {
+			return nil
+		}
+		find := tx.Scopes(scopes...).Scopes(Paginate(page, pageSize)).Find(&result.Items)
+		return handleQueryError("query page", find)
+	})
+	if err != nil {
+		if errors.Is(err, ErrDatabase) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("query page failed: %w: %w", ErrDatabase, err)
+	}
+
+	result.TotalPages = int((result.Total + int64(pageSize) - 1) / int64(pageSize))
+	return result, nil
+}
+
+// Delete removes rows matched by scopes. Like Update, it returns
+// ErrMissingCondition unless scopes add a WHERE condition or include
+// AllowFullTableUpdate. For
+// models with a gorm.DeletedAt field this is a soft delete that only sets the
+// deletion time; use HardDelete to remove the rows.
 func (r *Repo[T]) Delete(ctx context.Context, db *gorm.DB, scopes ...func(db *gorm.DB) *gorm.DB) error {
 	if db == nil {
 		return errors.New("delete: db is nil")
 	}
 	if len(scopes) == 0 {
-		return errors.New("delete: scope is required")
+		return fmt.Errorf("delete: %w", ErrMissingCondition)
 	}
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
 	result := db.WithContext(ctx).Model(new(T)).Scopes(scopes...).Delete(new(T))
 	return handleExecError("delete", result)
 }
 
+// HardDelete permanently removes rows matched by scopes, including rows that
+// were already soft deleted. The same ErrMissingCondition guard as Delete
+// applies.
+func (r *Repo[T]) HardDelete(ctx context.Context, db *gorm.DB, scopes ...func(db *gorm.DB) *gorm.DB) error {
+	if db == nil {
+		return errors.New("hard delete: db is nil")
+	}
+	if len(scopes) == 0 {
+		return fmt.Errorf("hard delete: %w", ErrMissingCondition)
+	}
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
+	result := db.WithContext(ctx).Unscoped().Model(new(T)).Scopes(scopes...).Delete(new(T))
+	return handleExecError("hard delete", result)
+}
+
 // Raw executes a query and scans rows into []T.
 func (r *Repo[T]) Raw(ctx context.Context, db *gorm.DB, sql string, args ...any) ([]T, error) {
 	if db == nil {
@@ -158,11 +415,28 @@ func (r *Repo[T]) Raw(ctx context.Context, db *gorm.DB, sql string, args ...any)
 	if sql == "" {
 		return nil, errors.New("raw: sql is empty")
 	}
+	ctx, cancel := r.withTimeout(ctx)
+	defer cancel()
 	results := []T{}
 	result := db.WithContext(ctx).Raw(sql, args...).Find(&results)
 	return results, handleQueryError("raw", result)
 }
 
+// Pluck returns column from the rows of model T matched by scopes without
+// loading whole rows. It is a function rather than a Repo method because
+// methods cannot declare the value type V.
+func Pluck[T, V any](ctx context.Context, db *gorm.DB, column string, scopes ...func(db *gorm.DB) *gorm.DB) ([]V, error) {
+	if db == nil {
+		return nil, errors.New("pluck: db is nil")
+	}
+	if column == "" {
+		return nil, errors.New("pluck: column is empty")
+	}
+	values := []V{}
+	result := db.WithContext(ctx).Model(new(T)).Scopes(scopes...).Pluck(column, &values)
+	return values, handleQueryError("pluck", result)
+}
+
 // Exec executes a statement and allows zero affected rows.
 func Exec(ctx context.Context, db *gorm.DB, sql string, args ...any) error {
 	if db == nil {
@@ -178,7 +452,64 @@ func Exec(ctx context.Context, db *gorm.DB, sql string, args ...any) error {
 	return nil
 }
 
+// WithTransaction runs fn inside a transaction on db. The transaction commits
+// when fn returns nil and rolls back when fn returns an error or panics; a
+// panic is re-raised after the rollback. Pass tx, not db, to repository calls
+// made inside fn. Called on a db that is already in a transaction, it uses a
+// savepoint. Errors from repository calls, which already match one of this
+// package's sentinel errors, are returned unchanged; other errors are wrapped
+// in ErrDatabase.
+func WithTransaction(ctx context.Context, db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	if db == nil {
+		return errors.New("transaction: db is nil")
+	}
+	if fn == nil {
+		return errors.New("transaction: fn is nil")
+	}
+	err := db.WithContext(ctx).Transaction(fn)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, ErrDatabase), errors.Is(err, ErrNotFound),
+		errors.Is(err, ErrNoRowsAffected), errors.Is(err, ErrMissingCondition):
+		return err
+	default:
+		return fmt.Errorf("transaction failed: %w: %w", ErrDatabase, err)
+	}
+}
+
+// primaryKeyEqual returns a scope matching T's primary key column, taken from
+// the GORM schema and falling back to "id".
+func primaryKeyEqual[T any](id any) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		column := "id"
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(new(T)); err == nil && stmt.Schema.PrioritizedPrimaryField != nil {
+			column = stmt.Schema.PrioritizedPrimaryField.DBName
+		}
+		return db.Where(db.Statement.Quote(column)+" = ?", id)
+	}
+}
+
+// withTimeout applies the repo's default timeout to ctx unless ctx already
+// has a deadline.
+func (r *Repo[T]) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if r.queryTimeout <= 0 {
+		return ctx, func() {}
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, r.queryTimeout)
+}
+
 func handleExecError(op string, result *gorm.DB) error {
+	if errors.Is(result.Error, gorm.ErrMissingWhereClause) {
+		return fmt.Errorf("%s: %w", op, ErrMissingCondition)
+	}
 	if result.Error != nil {
 		return fmt.Errorf("%s failed: %w: %w", op, ErrDatabase, result.Error)
 	}
@@ -3,7 +3,9 @@ package dal
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -58,6 +60,47 @@ func TestRepo_BatchInsert(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestRepo_Upsert(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepo[testUser]()
+	ctx := context.Background()
+
+	require.NoError(t, repo.Upsert(ctx, db, &testUser{ID: 1, Name: "Alice", Age: 25}, []string{"id"}, nil))
+	require.NoError(t, repo.Upsert(ctx, db, &testUser{ID: 1, Name: "Alicia", Age: 26}, []string{"id"}, []string{"age"}))
+
+	var got testUser
+	require.NoError(t, db.First(&got, 1).Error)
+	assert.Equal(t, "Alice", got.Name)
+	assert.Equal(t, 26, got.Age)
+
+	require.NoError(t, repo.Upsert(ctx, db, &testUser{ID: 1, Name: "Alicia", Age: 27}, []string{"id"}, nil))
+	require.NoError(t, db.First(&got, 1).Error)
+	assert.Equal(t, "Alicia", got.Name)
+	assert.Equal(t, 27, got.Age)
+
+	var count int64
+	require.NoError(t, db.Model(&testUser{}).Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestRepo_Upsert_DryRunSQL(t *testing.T) {
+	db, sql := setupDryRunDB(t)
+	repo := NewRepo[testUser]()
+
+	require.NoError(t, repo.Upsert(context.Background(), db, &testUser{Name: "Bob"}, []string{"name"}, []string{"age"}))
+	assert.Contains(t, *sql, "ON CONFLICT (`name`) DO UPDATE SET `age`=`excluded`.`age`")
+}
+
+func TestRepo_Upsert_InvalidArgs(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepo[testUser]()
+	ctx := context.Background()
+
+	assert.Error(t, repo.Upsert(ctx, nil, &testUser{}, []string{"id"}, nil))
+	assert.Error(t, repo.Upsert(ctx, db, nil, []string{"id"}, nil))
+	assert.Error(t, repo.Upsert(ctx, db, &testUser{}, nil, nil))
+}
+
 func TestRepo_UpdateFields(t *testing.T) {
 	db := setupTestDB(t)
 	repo := NewRepo[testUser]()
@@ -86,6 +129,49 @@ func TestRepo_UpdateFields_NoScope(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestRepo_FullTableGuard(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepo[testUser]()
+	ctx := context.Background()
+
+	require.NoError(t, db.Create(&[]testUser{{Name: "A", Age: 1}, {Name: "B", Age: 2}}).Error)
+
+	tests := []struct {
+		name string
+		run  func(scopes ...func(db *gorm.DB) *gorm.DB) error
+	}{
+		{"update", func(scopes ...func(db *gorm.DB) *gorm.DB) error {
+			return repo.Update(ctx, db, &testUser{Age: 10}, scopes...)
+		}},
+		{"update fields", func(scopes ...func(db *gorm.DB) *gorm.DB) error {
+			return repo.UpdateFields(ctx, db, map[string]any{"age": 20}, scopes...)
+		}},
+		{"delete", func(scopes ...func(db *gorm.DB) *gorm.DB) error {
+			return repo.Delete(ctx, db, scopes...)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.run(), ErrMissingCondition)
+			assert.ErrorIs(t, tt.run(Limit(1)), ErrMissingCondition)
+
+			count, err := repo.Count(ctx, db)
+			require.NoError(t, err)
+			assert.Equal(t, int64(2), count)
+		})
+	}
+
+	require.NoError(t, repo.UpdateFields(ctx, db, map[string]any{"age": 30}, AllowFullTableUpdate()))
+	count, err := repo.Count(ctx, db, Equal("age", 30))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	require.NoError(t, repo.Delete(ctx, db, AllowFullTableUpdate()))
+	count, err = repo.Count(ctx, db)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
 func TestRepo_QueryOne(t *testing.T) {
 	db := setupTestDB(t)
 	repo := NewRepo[testUser]()
@@ -104,8 +190,78 @@ func TestRepo_QueryOne_NotFound(t *testing.T) {
 
 	result, err := repo.QueryOne(context.Background(), db, Equal("name", "NonExistent"))
 	assert.Error(t, err)
-	assert.True(t, errors.Is(err, ErrDatabase))
+	assert.True(t, errors.Is(err, ErrNotFound))
+	assert.True(t, errors.Is(err, gorm.ErrRecordNotFound))
+	assert.False(t, errors.Is(err, ErrDatabase))
+	assert.Nil(t, result)
+}
+
+func TestRepo_QueryOneOrNil(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepo[testUser]()
+	ctx := context.Background()
+
+	result, err := repo.QueryOneOrNil(ctx, db, Equal("name", "NonExistent"))
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+
+	require.NoError(t, db.Create(&testUser{Name: "FindMe", Age: 20}).Error)
+	result, err = repo.QueryOneOrNil(ctx, db, Equal("name", "FindMe"))
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "FindMe", result.Name)
+
+	_, err = repo.QueryOneOrNil(ctx, nil)
+	assert.Error(t, err)
+}
+
+type testCode struct {
+	Code string `gorm:"primaryKey;size:32"`
+	Name string `gorm:"size:255"`
+}
+
+func TestRepo_FindByID(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepo[testUser]()
+	ctx := context.Background()
+
+	user := &testUser{Name: "Alice", Age: 25}
+	require.NoError(t, db.Create(user).Error)
+
+	result, err := repo.FindByID(ctx, db, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Alice", result.Name)
+
+	result, err = repo.FindByID(ctx, db, user.ID+1)
+	assert.ErrorIs(t, err, ErrNotFound)
 	assert.Nil(t, result)
+
+	exists, err := repo.ExistsByID(ctx, db, user.ID)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = repo.ExistsByID(ctx, db, user.ID+1)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = repo.FindByID(ctx, nil, 1)
+	assert.Error(t, err)
+	_, err = repo.ExistsByID(ctx, nil, 1)
+	assert.Error(t, err)
+}
+
+func TestRepo_FindByID_DryRunSQL(t *testing.T) {
+	db, sql := setupDryRunDB(t)
+	ctx := context.Background()
+
+	_, _ = NewRepo[testUser]().FindByID(ctx, db, 7)
+	assert.Contains(t, *sql, "WHERE `id` = 7")
+
+	_, _ = NewRepo[testCode]().FindByID(ctx, db, "abc")
+	assert.Contains(t, *sql, "WHERE `code` = \"abc\"")
+
+	_, _ = NewRepo[testCode]().ExistsByID(ctx, db, "abc")
+	assert.Contains(t, *sql, "SELECT count(*) FROM `test_codes` WHERE `code` = \"abc\"")
 }
 
 func TestRepo_Query(t *testing.T) {
@@ -120,6 +276,118 @@ func TestRepo_Query(t *testing.T) {
 	assert.Len(t, results, 2)
 }
 
+func TestRepo_QueryPage(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepo[testUser]()
+	ctx := context.Background()
+
+	for i := 1; i <= 25; i++ {
+		require.NoError(t, db.Create(&testUser{Name: "User", Age: i}).Error)
+	}
+	require.NoError(t, db.Create(&testUser{Name: "Other", Age: 99}).Error)
+
+	tests := []struct {
+		name         string
+		page         int
+		pageSize     int
+		wantItems    int
+		wantPage     int
+		wantSize     int
+		wantPages    int
+		wantFirstAge int
+	}{
+		{"first page", 1, 10, 10, 1, 10, 3, 1},
+		{"last partial page", 3, 10, 5, 3, 10, 3, 21},
+		{"beyond last page", 4, 10, 0, 4, 10, 3, 0},
+		{"exact division", 1, 5, 5, 1, 5, 5, 1},
+		{"normalized", 0, 0, 10, 1, DefaultPageSize, 3, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := repo.QueryPage(ctx, db, tt.page, tt.pageSize, Equal("name", "User"), Order("age", "asc"))
+			require.NoError(t, err)
+			assert.Equal(t, int64(25), page.Total)
+			assert.Equal(t, tt.wantPage, page.Page)
+			assert.Equal(t, tt.wantSize, page.PageSize)
+			assert.Equal(t, tt.wantPages, page.TotalPages)
+			require.Len(t, page.Items, tt.wantItems)
+			if tt.wantItems > 0 {
+				assert.Equal(t, tt.wantFirstAge, page.Items[0].Age)
+			}
+		})
+	}
+}
+
+func TestRepo_QueryPage_Empty(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepo[testUser]()
+
+	page, err := repo.QueryPage(context.Background(), db, 1, 10)
+	require.NoError(t, err)
+	assert.NotNil(t, page.Items)
+	assert.Empty(t, page.Items)
+	assert.Zero(t, page.Total)
+	assert.Zero(t, page.TotalPages)
+
+	_, err = repo.QueryPage(context.Background(), nil, 1, 10)
+	assert.Error(t, err)
+}
+
+func TestRepo_QueryInBatches(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepo[testUser]()
+	ctx := context.Background()
+
+	for i := 1; i <= 7; i++ {
+		require.NoError(t, db.Create(&testUser{Name: "User", Age: i}).Error)
+	}
+	require.NoError(t, db.Create(&testUser{Name: "Other", Age: 99}).Error)
+
+	var sizes []int
+	var ages []int
+	err := repo.QueryInBatches(ctx, db, 3, func(batch []testUser) error {
+		sizes = append(sizes, len(batch))
+		for _, u := range batch {
+			ages = append(ages, u.Age)
+		}
+		return nil
+	}, Equal("name", "User"))
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 3, 1}, sizes)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7}, ages)
+}
+
+func TestRepo_QueryInBatches_StopsOnError(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepo[testUser]()
+	ctx := context.Background()
+
+	for i := 1; i <= 5; i++ {
+		require.NoError(t, db.Create(&testUser{Name: "User", Age: i}).Error)
+	}
+
+	errStop := errors.New("stop")
+	calls := 0
+	err := repo.QueryInBatches(ctx, db, 2, func([]testUser) error {
+		calls++
+		return errStop
+	})
+	assert.ErrorIs(t, err, errStop)
+	assert.NotErrorIs(t, err, ErrDatabase)
+	assert.Equal(t, 1, calls)
+
+	assert.Error(t, repo.QueryInBatches(ctx, nil, 2, func([]testUser) error { return nil }))
+	assert.Error(t, repo.QueryInBatches(ctx, db, 2, nil))
+}
+
+func TestRepo_QueryInBatches_DryRunSQL(t *testing.T) {
+	db, sql := setupDryRunDB(t)
+	repo := NewRepo[testUser]()
+
+	_ = repo.QueryInBatches(context.Background(), db, 50, func([]testUser) error { return nil }, Equal("name", "x"))
+	assert.Equal(t, "SELECT * FROM `test_users` WHERE `name` = \"x\" ORDER BY `test_users`.`id` LIMIT 50", *sql)
+}
+
 func TestRepo_Count(t *testing.T) {
 	db := setupTestDB(t)
 	repo := NewRepo[testUser]()
@@ -149,6 +417,63 @@ func TestRepo_Delete_NoScope(t *testing.T) {
 	assert.Error(t, err)
 }
 
+type testNote struct {
+	ID        uint `gorm:"primarykey"`
+	Title     string
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+func TestRepo_SoftDelete(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&testNote{}))
+	repo := NewRepo[testNote]()
+	ctx := context.Background()
+
+	require.NoError(t, db.Create(&[]testNote{{Title: "a"}, {Title: "b"}, {Title: "c"}}).Error)
+
+	require.NoError(t, repo.Delete(ctx, db, Equal("title", "a")))
+	require.NoError(t, repo.HardDelete(ctx, db, Equal("title", "b")))
+
+	visible, err := repo.Query(ctx, db)
+	require.NoError(t, err)
+	require.Len(t, visible, 1)
+	assert.Equal(t, "c", visible[0].Title)
+
+	all, err := repo.Query(ctx, db, WithTrashed())
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	trashed, err := repo.Query(ctx, db, OnlyTrashed())
+	require.NoError(t, err)
+	require.Len(t, trashed, 1)
+	assert.Equal(t, "a", trashed[0].Title)
+
+	require.NoError(t, repo.HardDelete(ctx, db, Equal("title", "a")))
+	count, err := repo.Count(ctx, db, Unscoped())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	assert.Error(t, repo.HardDelete(ctx, db))
+	assert.Error(t, repo.HardDelete(ctx, nil, Equal("id", 1)))
+}
+
+func TestRepo_SoftDelete_DryRunSQL(t *testing.T) {
+	db := setupTestDB(t)
+	require.NoError(t, db.AutoMigrate(&testNote{}))
+	dry := db.Session(&gorm.Session{DryRun: true})
+
+	stmt := dry.Scopes(Equal("id", 1)).Delete(&testNote{}).Statement
+	assert.Contains(t, stmt.SQL.String(), "UPDATE `test_notes` SET `deleted_at`=")
+
+	stmt = dry.Scopes(Unscoped(), Equal("id", 1)).Delete(&testNote{}).Statement
+	assert.Equal(t, "DELETE FROM `test_notes` WHERE `id` = ?", stmt.SQL.String())
+
+	sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB { return tx.Find(&[]testNote{}) })
+	assert.Contains(t, sql, "`test_notes`.`deleted_at` IS NULL")
+	sql = db.ToSQL(func(tx *gorm.DB) *gorm.DB { return tx.Scopes(WithTrashed()).Find(&[]testNote{}) })
+	assert.Equal(t, "SELECT * FROM `test_notes`", sql)
+}
+
 func TestRepo_Raw(t *testing.T) {
 	db := setupTestDB(t)
 	repo := NewRepo[testUser]()
@@ -160,6 +485,35 @@ func TestRepo_Raw(t *testing.T) {
 	assert.Len(t, results, 1)
 }
 
+func TestPluck(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, db.Create(&[]testUser{{Name: "A", Age: 30}, {Name: "B", Age: 20}, {Name: "C", Age: 40}}).Error)
+
+	names, err := Pluck[testUser, string](ctx, db, "name", GreaterThan("age", 25), Order("age", "desc"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"C", "A"}, names)
+
+	ages, err := Pluck[testUser, int](ctx, db, "age", Equal("name", "none"))
+	require.NoError(t, err)
+	assert.NotNil(t, ages)
+	assert.Empty(t, ages)
+
+	_, err = Pluck[testUser, int](ctx, nil, "age")
+	assert.Error(t, err)
+	_, err = Pluck[testUser, int](ctx, db, "")
+	assert.Error(t, err)
+}
+
+func TestPluck_DryRunSQL(t *testing.T) {
+	db, sql := setupDryRunDB(t)
+
+	_, err := Pluck[testUser, uint](context.Background(), db, "id", Equal("name", "x"))
+	require.NoError(t, err)
+	assert.Equal(t, "SELECT `id` FROM `test_users` WHERE `name` = \"x\"", *sql)
+}
+
 func TestExec(t *testing.T) {
 	db := setupTestDB(t)
 	require.NoError(t, db.Create(&testUser{Name: "ToDelete", Age: 20}).Error)
@@ -184,6 +538,142 @@ func TestExec_NilDB(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestWithTransaction_Commit(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepo[testUser]()
+	ctx := context.Background()
+
+	err := WithTransaction(ctx, db, func(tx *gorm.DB) error {
+		return repo.Insert(ctx, tx, &testUser{Name: "Committed"})
+	})
+	require.NoError(t, err)
+
+	count, err := repo.Count(ctx, db, Equal("name", "Committed"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestWithTransaction_RollbackOnError(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepo[testUser]()
+	ctx := context.Background()
+	errBoom := errors.New("boom")
+
+	err := WithTransaction(ctx, db, func(tx *gorm.DB) error {
+		require.NoError(t, repo.Insert(ctx, tx, &testUser{Name: "RolledBack"}))
+		return errBoom
+	})
+	assert.ErrorIs(t, err, ErrDatabase)
+	assert.ErrorIs(t, err, errBoom)
+
+	count, err := repo.Count(ctx, db)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestWithTransaction_RepoErrorsUnchanged(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepo[testUser]()
+	ctx := context.Background()
+
+	var repoErr error
+	err := WithTransaction(ctx, db, func(tx *gorm.DB) error {
+		_, repoErr = repo.QueryOne(ctx, tx, Equal("name", "Missing"))
+		return repoErr
+	})
+	require.ErrorIs(t, repoErr, ErrNotFound)
+	assert.Equal(t, repoErr, err)
+
+	err = WithTransaction(ctx, db, func(tx *gorm.DB) error {
+		return tx.Exec("SELECT * FROM missing_table").Error
+	})
+	assert.ErrorIs(t, err, ErrDatabase)
+
+	err = WithTransaction(ctx, db, func(tx *gorm.DB) error {
+		return Exec(ctx, tx, "SELECT * FROM missing_table")
+	})
+	require.ErrorIs(t, err, ErrDatabase)
+	assert.Equal(t, 1, strings.Count(err.Error(), ErrDatabase.Error()))
+}
+
+func TestWithTransaction_RollbackOnPanic(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepo[testUser]()
+	ctx := context.Background()
+
+	assert.PanicsWithValue(t, "boom", func() {
+		_ = WithTransaction(ctx, db, func(tx *gorm.DB) error {
+			require.NoError(t, repo.Insert(ctx, tx, &testUser{Name: "Panicked"}))
+			panic("boom")
+		})
+	})
+
+	count, err := repo.Count(ctx, db)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestWithTransaction_InvalidArgs(t *testing.T) {
+	ctx := context.Background()
+	assert.Error(t, WithTransaction(ctx, nil, func(*gorm.DB) error { return nil }))
+	assert.Error(t, WithTransaction(ctx, setupTestDB(t), nil))
+}
+
+func TestRepo_QueryTimeout(t *testing.T) {
+	db := setupTestDB(t)
+
+	var deadline time.Time
+	var hasDeadline bool
+	require.NoError(t, db.Callback().Query().Before("gorm:query").Register("test:slow", func(tx *gorm.DB) {
+		deadline, hasDeadline = tx.Statement.Context.Deadline()
+		<-tx.Statement.Context.Done()
+		_ = tx.AddError(tx.Statement.Context.Err())
+	}))
+
+	repo := NewRepoWithOptions[testUser](WithQueryTimeout(time.Millisecond))
+	_, err := repo.Query(context.Background(), db)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.True(t, hasDeadline)
+
+	// A caller deadline is kept rather than replaced.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	want, _ := ctx.Deadline()
+	_, err = repo.Count(ctx, db)
+	assert.ErrorIs(t, err, ErrDatabase)
+	assert.Equal(t, want, deadline)
+}
+
+func TestRepo_QueryInBatches_IgnoresDefaultTimeout(t *testing.T) {
+	db := setupTestDB(t)
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, db.Create(&testUser{Name: "User", Age: i}).Error)
+	}
+
+	repo := NewRepoWithOptions[testUser](WithQueryTimeout(5 * time.Millisecond))
+	var batches int
+	err := repo.QueryInBatches(context.Background(), db, 1, func([]testUser) error {
+		batches++
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, batches)
+}
+
+func TestRepo_NoDefaultTimeout(t *testing.T) {
+	db := setupTestDB(t)
+
+	hasDeadline := true
+	require.NoError(t, db.Callback().Query().Before("gorm:query").Register("test:deadline", func(tx *gorm.DB) {
+		_, hasDeadline = tx.Statement.Context.Deadline()
+	}))
+
+	_, err := NewRepoWithOptions[testUser]().Query(context.Background(), db)
+	require.NoError(t, err)
+	assert.False(t, hasDeadline)
+}
+
 func TestRepositoryInterface(t *testing.T) {
 	var repo Repository[testUser] = NewRepo[testUser]()
 	assert.NotNil(t, repo)
@@ -202,3 +692,17 @@ func setupTestDB(t *testing.T) *gorm.DB {
 	}
 	return db
 }
+
+// setupDryRunDB returns a DryRun session and a pointer to the SQL of the last
+// create or query it built.
+func setupDryRunDB(t *testing.T) (*gorm.DB, *string) {
+	db := setupTestDB(t).Session(&gorm.Session{DryRun: true})
+
+	var sql string
+	capture := func(tx *gorm.DB) {
+		sql = db.Dialector.Explain(tx.Statement.SQL.String(), tx.Statement.Vars...)
+	}
+	require.NoError(t, db.Callback().Create().After("gorm:create").Register("test:capture_sql", capture))
+	require.NoError(t, db.Callback().Query().After("gorm:query").Register("test:capture_sql", capture))
+	return db, &sql
+}
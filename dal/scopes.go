@@ -33,6 +33,15 @@ type Number interface {
 
 // Paginate returns a scope that applies offset and limit for 1-based pages.
 func Paginate(page, pageSize int) func(db *gorm.DB) *gorm.DB {
+	page, pageSize = normalizePage(page, pageSize)
+	offset := (page - 1) * pageSize
+
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Offset(offset).Limit(pageSize)
+	}
+}
+
+func normalizePage(page, pageSize int) (int, int) {
 	if page <= 0 {
 		page = 1
 	}
@@ -40,12 +49,28 @@ func Paginate(page, pageSize int) func(db *gorm.DB) *gorm.DB {
 	if pageSize <= 0 {
 		pageSize = DefaultPageSize
 	}
-	pageSize = min(pageSize, MaxPageSize)
+	return page, min(pageSize, MaxPageSize)
+}
 
-	offset := (page - 1) * pageSize
+// KeysetPaginate returns a scope for keyset (cursor) pagination over column,
+// which should be unique and indexed. It orders by column and returns rows
+// after lastValue, or before it when desc is true. Pass a nil lastValue for the
+// first page. Unlike Paginate, the cost of a page does not grow with its depth.
+// pageSize is normalized the same way as in Paginate.
+func KeysetPaginate(column string, lastValue any, pageSize int, desc bool) func(db *gorm.DB) *gorm.DB {
+	_, pageSize = normalizePage(1, pageSize)
+
+	operator, direction := " > ?", " ASC"
+	if desc {
+		operator, direction = " < ?", " DESC"
+	}
 
 	return func(db *gorm.DB) *gorm.DB {
-		return db.Offset(offset).Limit(pageSize)
+		quoted := db.Statement.Quote(column)
+		if lastValue != nil {
+			db = db.Where(quoted+operator, lastValue)
+		}
+		return db.Order(quoted + direction).Limit(pageSize)
 	}
 }
 
@@ -85,7 +110,9 @@ func LessThanOrEqual[T RangeValue](column string, value T) func(db *gorm.DB) *go
 	}
 }
 
-// When values is empty, it returns a condition that never matches.
+// In returns a scope matching column IN values, expanding the slice into
+// placeholders. When values is empty, it returns a condition that never
+// matches, since "IN ()" is a syntax error in most databases.
 func In[T ScalarValue](column string, values []T) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
 		if len(values) == 0 {
@@ -95,7 +122,8 @@ func In[T ScalarValue](column string, values []T) func(db *gorm.DB) *gorm.DB {
 	}
 }
 
-// When values is empty, it applies no filter.
+// NotIn returns a scope matching column NOT IN values. When values is empty,
+// it applies no filter, since nothing is excluded.
 func NotIn[T ScalarValue](column string, values []T) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
 		if len(values) == 0 {
@@ -129,7 +157,59 @@ func IsNotNull(column string) func(db *gorm.DB) *gorm.DB {
 	}
 }
 
+// Or returns a scope that matches rows satisfying any of scopes, producing a
+// parenthesized OR group such as (`name` LIKE ? OR `email` LIKE ?). It relies
+// on GORM's grouped conditions, so conditions within a single scope stay ANDed
+// together, and only the WHERE conditions of each scope are used; ordering,
+// limits, joins, and other clauses they add are ignored. A scope that calls
+// db.Scopes itself is expanded only one level deep. Scopes that add no
+// condition are skipped, and when none adds one the returned scope applies no
+// filter.
+func Or(scopes ...func(db *gorm.DB) *gorm.DB) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		group := db.Session(&gorm.Session{NewDB: true})
+		for _, scope := range scopes {
+			group = group.Or(scope(db.Session(&gorm.Session{NewDB: true})))
+		}
+		return db.Where(group)
+	}
+}
+
+// AllowFullTableUpdate returns a scope that lets Update, UpdateFields, Delete,
+// and HardDelete affect every row when no other condition is given. Without it
+// they return ErrMissingCondition. It adds an always-true condition, which also
+// satisfies GORM's own check against global updates.
+func AllowFullTableUpdate() func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("1 = 1")
+	}
+}
+
+// Unscoped returns a scope that disables GORM's soft-delete handling for
+// models with a gorm.DeletedAt field: queries include soft-deleted rows and
+// deletes become permanent.
+func Unscoped() func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Unscoped()
+	}
+}
+
+// WithTrashed returns a scope for queries that includes soft-deleted rows. It
+// is Unscoped under a name that reads better in queries.
+func WithTrashed() func(db *gorm.DB) *gorm.DB {
+	return Unscoped()
+}
+
+// OnlyTrashed returns a scope for queries that matches only soft-deleted rows.
+// The model must have a DeletedAt field stored in the deleted_at column.
+func OnlyTrashed() func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Unscoped().Where(db.Statement.Quote("deleted_at") + " IS NOT NULL")
+	}
+}
+
 // Order returns a scope that orders by column. Only "desc" selects descending order.
+// The column is quoted as an identifier, so it cannot inject SQL.
 func Order(column, direction string) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
 		sortDirection := "ASC"
@@ -140,6 +220,31 @@ func Order(column, direction string) func(db *gorm.DB) *gorm.DB {
 	}
 }
 
+// Sort is one ORDER BY term used by OrderBy.
+type Sort struct {
+	Column string
+	Desc   bool
+}
+
+// OrderBy returns a scope that orders by each sort in turn, for stable sorts
+// across several columns. Columns are quoted like in Order, and sorts with an
+// empty column are skipped.
+func OrderBy(sorts ...Sort) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		for _, sort := range sorts {
+			if sort.Column == "" {
+				continue
+			}
+			direction := " ASC"
+			if sort.Desc {
+				direction = " DESC"
+			}
+			db = db.Order(db.Statement.Quote(sort.Column) + direction)
+		}
+		return db
+	}
+}
+
 // When limit is non-positive, it applies no limit.
 func Limit(limit int) func(db *gorm.DB) *gorm.DB {
 	return func(db *gorm.DB) *gorm.DB {
@@ -1,9 +1,12 @@
 package dal
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -34,6 +37,52 @@ func TestPaginate(t *testing.T) {
 	assert.Equal(t, 10, len(products))
 }
 
+func TestKeysetPaginate_SQL(t *testing.T) {
+	db := setupTestDBForScopes(t)
+
+	tests := []struct {
+		name  string
+		scope func(db *gorm.DB) *gorm.DB
+		want  string
+	}{
+		{"first page", KeysetPaginate("id", nil, 20, false), "ORDER BY `id` ASC LIMIT 20"},
+		{"forward", KeysetPaginate("id", 42, 20, false), "WHERE `id` > 42 ORDER BY `id` ASC LIMIT 20"},
+		{"reverse", KeysetPaginate("id", 42, 20, true), "WHERE `id` < 42 ORDER BY `id` DESC LIMIT 20"},
+		{"default page size", KeysetPaginate("id", nil, 0, true), "ORDER BY `id` DESC LIMIT 10"},
+		{"capped page size", KeysetPaginate("id", 1, 1000, false), "WHERE `id` > 1 ORDER BY `id` ASC LIMIT 100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return tx.Scopes(tt.scope).Find(&[]testProduct{})
+			})
+			assert.Equal(t, "SELECT * FROM `test_products` "+tt.want, sql)
+		})
+	}
+}
+
+func TestKeysetPaginate_Pages(t *testing.T) {
+	db := setupTestDBForScopes(t)
+	for i := 1; i <= 5; i++ {
+		db.Create(&testProduct{Name: "Product", Price: float64(i)})
+	}
+
+	var ids []uint
+	var last any
+	for {
+		var page []testProduct
+		require.NoError(t, db.Scopes(KeysetPaginate("id", last, 2, false)).Find(&page).Error)
+		if len(page) == 0 {
+			break
+		}
+		for _, p := range page {
+			ids = append(ids, p.ID)
+		}
+		last = page[len(page)-1].ID
+	}
+	assert.Equal(t, []uint{1, 2, 3, 4, 5}, ids)
+}
+
 func TestEqual(t *testing.T) {
 	db := setupTestDBForScopes(t)
 	db.Create(&testProduct{Name: "Apple", Price: 1.5})
@@ -107,6 +156,29 @@ func TestNotIn(t *testing.T) {
 	assert.Len(t, products, 1)
 }
 
+func TestInAndNotIn_SQL(t *testing.T) {
+	db := setupTestDBForScopes(t)
+
+	tests := []struct {
+		name  string
+		scope func(db *gorm.DB) *gorm.DB
+		want  string
+	}{
+		{"in", In("id", []int{1, 2, 3}), "SELECT * FROM `test_products` WHERE `id` IN (1,2,3)"},
+		{"in empty", In("id", []int{}), "SELECT * FROM `test_products` WHERE 1 = 0"},
+		{"not in", NotIn("id", []int{1, 2, 3}), "SELECT * FROM `test_products` WHERE `id` NOT IN (1,2,3)"},
+		{"not in empty", NotIn("id", []int(nil)), "SELECT * FROM `test_products`"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return tx.Scopes(tt.scope).Find(&[]testProduct{})
+			})
+			assert.Equal(t, tt.want, sql)
+		})
+	}
+}
+
 func TestBetween(t *testing.T) {
 	db := setupTestDBForScopes(t)
 	db.Create(&testProduct{Name: "Item1", Price: 1.0})
@@ -120,6 +192,107 @@ func TestBetween(t *testing.T) {
 	assert.Len(t, products, 2)
 }
 
+func TestRangeScopes_SQL(t *testing.T) {
+	db := setupTestDBForScopes(t)
+
+	tests := []struct {
+		name  string
+		scope func(db *gorm.DB) *gorm.DB
+		want  string
+	}{
+		{"greater than", GreaterThan("price", 1.5), "WHERE `price` > 1.5"},
+		{"greater than or equal", GreaterThanOrEqual("price", 2), "WHERE `price` >= 2"},
+		{"less than", LessThan("price", 3), "WHERE `price` < 3"},
+		{"less than or equal", LessThanOrEqual("price", 4.5), "WHERE `price` <= 4.5"},
+		{"between", Between("price", 1, 9), "WHERE `price` BETWEEN 1 AND 9"},
+		{"not between", NotBetween("price", 1, 9), "WHERE `price` NOT BETWEEN 1 AND 9"},
+		{
+			"combined",
+			func(db *gorm.DB) *gorm.DB {
+				return db.Scopes(GreaterThanOrEqual("price", 1), LessThan("price", 2), Paginate(2, 5))
+			},
+			"WHERE `price` >= 1 AND `price` < 2 LIMIT 5 OFFSET 5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return tx.Scopes(tt.scope).Find(&[]testProduct{})
+			})
+			assert.Equal(t, "SELECT * FROM `test_products` "+tt.want, sql)
+		})
+	}
+}
+
+func TestBetween_TimeRange(t *testing.T) {
+	db := setupTestDBForScopes(t)
+	start := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 3, 15, 23, 59, 59, 999999999, time.UTC)
+
+	sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		return tx.Scopes(Between("created_at", start, end)).Find(&[]testProduct{})
+	})
+	assert.Contains(t, sql, "WHERE `created_at` BETWEEN \"2024-03-15 00:00:00\" AND \"2024-03-15 23:59:59")
+}
+
+func TestOr_SQL(t *testing.T) {
+	db := setupTestDBForScopes(t)
+
+	tests := []struct {
+		name   string
+		scopes []func(db *gorm.DB) *gorm.DB
+		want   string
+	}{
+		{
+			name:   "or group",
+			scopes: []func(db *gorm.DB) *gorm.DB{Equal("id", 1), Or(Contains("name", "a"), StartsWith("name", "b"))},
+			want:   "WHERE `id` = 1 AND (`name` LIKE \"%a%\" ESCAPE '\\' OR `name` LIKE \"b%\" ESCAPE '\\')",
+		},
+		{
+			name: "and within branch",
+			scopes: []func(db *gorm.DB) *gorm.DB{Or(
+				func(db *gorm.DB) *gorm.DB { return db.Scopes(Equal("name", "x"), GreaterThan("price", 1)) },
+				Equal("name", "y"),
+			)},
+			want: "WHERE (`name` = \"x\" AND `price` > 1) OR `name` = \"y\"",
+		},
+		{
+			name:   "or before other conditions",
+			scopes: []func(db *gorm.DB) *gorm.DB{Or(Equal("name", "x"), Equal("name", "y")), Equal("id", 1)},
+			want:   "WHERE (`name` = \"x\" OR `name` = \"y\") AND `id` = 1",
+		},
+		{
+			name:   "single branch",
+			scopes: []func(db *gorm.DB) *gorm.DB{Or(Equal("name", "x"))},
+			want:   "WHERE `name` = \"x\"",
+		},
+		{
+			name:   "no conditions",
+			scopes: []func(db *gorm.DB) *gorm.DB{Or(), Or(Limit(0))},
+			want:   "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return tx.Scopes(tt.scopes...).Find(&[]testProduct{})
+			})
+			assert.Equal(t, strings.TrimSpace("SELECT * FROM `test_products` "+tt.want), sql)
+		})
+	}
+}
+
+func TestOr_Query(t *testing.T) {
+	db := setupTestDBForScopes(t)
+	db.Create(&testProduct{Name: "Apple", Price: 1.5})
+	db.Create(&testProduct{Name: "Banana", Price: 0.5})
+	db.Create(&testProduct{Name: "Orange", Price: 2.0})
+
+	var products []testProduct
+	db.Scopes(Or(Equal("name", "Apple"), GreaterThan("price", 1.8))).Order("id").Find(&products)
+	assert.Len(t, products, 2)
+}
+
 func TestOrderBy(t *testing.T) {
 	db := setupTestDBForScopes(t)
 	db.Create(&testProduct{Name: "C", Price: 3.0})
@@ -133,6 +306,46 @@ func TestOrderBy(t *testing.T) {
 	assert.Equal(t, "A", products[0].Name)
 }
 
+func TestOrderBy_Multi(t *testing.T) {
+	db := setupTestDBForScopes(t)
+	db.Create(&testProduct{Name: "B", Price: 1.0})
+	db.Create(&testProduct{Name: "A", Price: 2.0})
+	db.Create(&testProduct{Name: "A", Price: 1.0})
+
+	var products []testProduct
+	db.Scopes(OrderBy(Sort{Column: "name"}, Sort{Column: "price", Desc: true})).Find(&products)
+	require.Len(t, products, 3)
+	assert.Equal(t, []testProduct{
+		{ID: 2, Name: "A", Price: 2.0},
+		{ID: 3, Name: "A", Price: 1.0},
+		{ID: 1, Name: "B", Price: 1.0},
+	}, products)
+}
+
+func TestOrderBy_SQL(t *testing.T) {
+	db := setupTestDBForScopes(t)
+
+	tests := []struct {
+		name  string
+		scope func(db *gorm.DB) *gorm.DB
+		want  string
+	}{
+		{"multi", OrderBy(Sort{Column: "name"}, Sort{Column: "id", Desc: true}), "ORDER BY `name` ASC,`id` DESC"},
+		{"skips empty column", OrderBy(Sort{}, Sort{Column: "id"}), "ORDER BY `id` ASC"},
+		{"none", OrderBy(), ""},
+		{"quotes malicious column", Order("name`; DROP TABLE test_products; --", "desc"), "ORDER BY `name``; DROP TABLE test_products; --` DESC"},
+		{"quotes malicious sort", OrderBy(Sort{Column: "id` DESC, (SELECT 1)"}), "ORDER BY `id`` DESC, (SELECT 1)` ASC"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return tx.Scopes(tt.scope).Find(&[]testProduct{})
+			})
+			assert.Equal(t, strings.TrimSpace("SELECT * FROM `test_products` "+tt.want), sql)
+		})
+	}
+}
+
 func TestLimit(t *testing.T) {
 	db := setupTestDBForScopes(t)
 	db.Create(&testProduct{Name: "Item1", Price: 1.0})
@@ -158,6 +371,34 @@ func TestLike(t *testing.T) {
 	assert.Len(t, products, 2)
 }
 
+func TestLike_EscapesWildcards(t *testing.T) {
+	db := setupTestDBForScopes(t)
+	for _, name := range []string{"50% off", "500 off", "50_off", "5000", `a\b`, "ab"} {
+		db.Create(&testProduct{Name: name})
+	}
+
+	tests := []struct {
+		name  string
+		scope func(db *gorm.DB) *gorm.DB
+		want  []string
+	}{
+		{"contains percent", Contains("name", "50%"), []string{"50% off"}},
+		{"contains underscore", Contains("name", "0_o"), []string{"50_off"}},
+		{"contains backslash", Contains("name", `\`), []string{`a\b`}},
+		{"starts with percent", StartsWith("name", "50%"), []string{"50% off"}},
+		{"starts with underscore", StartsWith("name", "50_"), []string{"50_off"}},
+		{"ends with", EndsWith("name", "_off"), []string{"50_off"}},
+		{"plain", StartsWith("name", "50"), []string{"50% off", "500 off", "50_off", "5000"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var names []string
+			require.NoError(t, db.Model(&testProduct{}).Scopes(tt.scope).Order("id").Pluck("name", &names).Error)
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
 func TestPaginationConstants(t *testing.T) {
 	assert.Equal(t, 10, DefaultPageSize)
 	assert.Equal(t, 100, MaxPageSize)
@@ -0,0 +1,185 @@
+package dingtalk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/onnttf/kit/concurrent"
+)
+
+const (
+	defaultQueueSize     = 100
+	defaultMaxRetry      = 3
+	defaultRetryBase     = 500 * time.Millisecond
+	defaultRetryMaxDelay = 30 * time.Second
+)
+
+var (
+	// ErrQueueFull is returned by SendAsync when the async queue has no free slot.
+	ErrQueueFull = errors.New("async queue is full")
+	// ErrRobotClosed is returned by SendAsync after Close has been called.
+	ErrRobotClosed = errors.New("robot is closed")
+)
+
+type asyncQueue struct {
+	size     int
+	maxRetry int
+	backoff  concurrent.BackoffFunc
+	onError  func(Message, error)
+
+	mu     sync.Mutex
+	closed bool
+	ch     chan Message
+	done   chan struct{}
+	cancel context.CancelFunc
+}
+
+// WithQueueSize sets how many messages SendAsync can buffer. It must be called
+// before the first SendAsync; non-positive values are ignored.
+func (r *Robot) WithQueueSize(size int) *Robot {
+	if size > 0 {
+		r.async.size = size
+	}
+	return r
+}
+
+// WithRetry sets how often SendAsync retries a transient failure and the delay
+// before each retry. A nil backoff retries immediately.
+func (r *Robot) WithRetry(maxRetry int, backoff concurrent.BackoffFunc) *Robot {
+	if maxRetry >= 0 {
+		r.async.maxRetry = maxRetry
+	}
+	r.async.backoff = backoff
+	return r
+}
+
+// WithErrorHandler registers fn to receive messages that SendAsync could not
+// deliver, together with the last error.
+func (r *Robot) WithErrorHandler(fn func(msg Message, err error)) *Robot {
+	r.async.onError = fn
+	return r
+}
+
+// SendAsync validates msg, queues it for delivery by a background worker, and
+// returns without waiting for the request. The worker starts on first use.
+//
+// Delivery is at-least-once: network errors and 5xx responses are retried with
+// backoff, so a request that reached DingTalk but timed out may be posted
+// twice. The rate-limit errcode is only retried as set by WithRateLimitRetry.
+// Messages that still fail are passed to the handler set by WithErrorHandler.
+// Call Close to flush the queue.
+func (r *Robot) SendAsync(msg Message) error {
+	if err := ValidateMessage(msg); err != nil {
+		return err
+	}
+
+	q := &r.async
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return ErrRobotClosed
+	}
+	if q.ch == nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		q.ch = make(chan Message, q.size)
+		q.done = make(chan struct{})
+		q.cancel = cancel
+		go r.drain(ctx)
+	}
+
+	select {
+	case q.ch <- msg:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Close stops accepting async messages and waits until queued ones are sent.
+// If ctx ends first, in-flight and remaining messages are abandoned, reported
+// to the error handler, and ctx.Err() is returned. Close is safe to call more
+// than once. A nil context is treated as context.Background.
+func (r *Robot) Close(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	q := &r.async
+	q.mu.Lock()
+	if q.closed || q.ch == nil {
+		q.closed = true
+		q.mu.Unlock()
+		return nil
+	}
+	q.closed = true
+	close(q.ch)
+	q.mu.Unlock()
+
+	select {
+	case <-q.done:
+		q.cancel()
+		return nil
+	case <-ctx.Done():
+		q.cancel()
+		<-q.done
+		return ctx.Err()
+	}
+}
+
+func (r *Robot) drain(ctx context.Context) {
+	q := &r.async
+	defer close(q.done)
+
+	for msg := range q.ch {
+		err := ctx.Err()
+		if err == nil {
+			err = r.sendWithRetry(ctx, msg)
+		}
+		if err != nil && q.onError != nil {
+			q.onError(msg, err)
+		}
+	}
+}
+
+func (r *Robot) sendWithRetry(ctx context.Context, msg Message) error {
+	q := &r.async
+	for attempt := 1; ; attempt++ {
+		sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+		err := r.SendWithContext(sendCtx, msg)
+		cancel()
+
+		if err == nil || attempt > q.maxRetry || !isTransient(err) || ctx.Err() != nil {
+			return err
+		}
+
+		if q.backoff == nil {
+			continue
+		}
+		timer := time.NewTimer(q.backoff(attempt))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		}
+	}
+}
+
+// isTransient reports whether a send error is worth retrying. Failures of the
+// HTTP round trip are; a webhook URL that does not parse is not, although
+// url.Parse also reports it as a *url.Error. Rate-limit errors are not either:
+// SendAndResponseWithContext has already retried them.
+func isTransient(err error) bool {
+	var statusErr *statusError
+	if errors.As(err, &statusErr) {
+		return statusErr.code >= http.StatusInternalServerError || statusErr.code == http.StatusTooManyRequests
+	}
+
+	var urlErr *url.Error
+	return errors.As(err, &urlErr) && urlErr.Op != "parse"
+}
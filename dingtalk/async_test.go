@@ -0,0 +1,245 @@
+package dingtalk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onnttf/kit/concurrent"
+)
+
+// serverClient returns a client that sends every request to srv, keeping the
+// path and query of the original webhook URL.
+func serverClient(srv *httptest.Server) *http.Client {
+	target, _ := url.Parse(srv.URL)
+	transport := srv.Client().Transport
+	return &http.Client{
+		Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			req.URL.Scheme = target.Scheme
+			req.URL.Host = target.Host
+			return transport.RoundTrip(req)
+		}),
+	}
+}
+
+func TestRobot_SendAsync_RetriesTransientFailures(t *testing.T) {
+	var calls atomic.Int64
+	var mu sync.Mutex
+	var bodies []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		switch n {
+		case 1:
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		case 2:
+			_, _ = io.WriteString(w, `{"errcode":130101,"errmsg":"send too fast"}`)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		bodies = append(bodies, string(body))
+		mu.Unlock()
+		_, _ = io.WriteString(w, `{"errcode":0,"errmsg":"ok"}`)
+	}))
+	defer srv.Close()
+
+	var failed atomic.Int64
+	robot := NewRobot("test_token").
+		WithClient(serverClient(srv)).
+		WithRetry(3, concurrent.ConstantBackoff(time.Millisecond)).
+		WithRateLimitRetry(1, time.Millisecond).
+		WithErrorHandler(func(Message, error) { failed.Add(1) })
+
+	require.NoError(t, robot.SendAsync(NewTextMsg("first")))
+	require.NoError(t, robot.SendAsync(NewTextMsg("second")))
+	require.NoError(t, robot.Close(context.Background()))
+
+	assert.Equal(t, int64(4), calls.Load())
+	assert.Zero(t, failed.Load())
+	require.Len(t, bodies, 2)
+	assert.Contains(t, bodies[0], `"content":"first"`)
+	assert.Contains(t, bodies[1], `"content":"second"`)
+}
+
+func TestRobot_SendAsync_ReportsPermanentFailure(t *testing.T) {
+	var calls atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		_, _ = io.WriteString(w, `{"errcode":310000,"errmsg":"keywords not in content"}`)
+	}))
+	defer srv.Close()
+
+	var gotErr error
+	robot := NewRobot("test_token").
+		WithClient(serverClient(srv)).
+		WithRetry(3, nil).
+		WithErrorHandler(func(_ Message, err error) { gotErr = err })
+
+	require.NoError(t, robot.SendAsync(NewTextMsg("hello")))
+	require.NoError(t, robot.Close(context.Background()))
+
+	assert.Equal(t, int64(1), calls.Load())
+	assert.ErrorIs(t, gotErr, ErrUnexpectedResponse)
+}
+
+func TestRobot_SendAsync_GivesUpAfterMaxRetry(t *testing.T) {
+	var calls atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	var gotErr error
+	robot := NewRobot("test_token").
+		WithClient(serverClient(srv)).
+		WithRetry(2, nil).
+		WithErrorHandler(func(_ Message, err error) { gotErr = err })
+
+	require.NoError(t, robot.SendAsync(NewTextMsg("hello")))
+	require.NoError(t, robot.Close(context.Background()))
+
+	assert.Equal(t, int64(3), calls.Load())
+	assert.ErrorIs(t, gotErr, ErrUnexpectedStatus)
+}
+
+func TestRobot_SendAsync_QueueFullAndClosed(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-release
+		_, _ = io.WriteString(w, `{"errcode":0,"errmsg":"ok"}`)
+	}))
+	defer srv.Close()
+
+	robot := NewRobot("test_token").WithClient(serverClient(srv)).WithQueueSize(1)
+
+	require.NoError(t, robot.SendAsync(NewTextMsg("in flight")))
+	require.Eventually(t, func() bool {
+		return len(robot.async.ch) == 0
+	}, time.Second, time.Millisecond)
+	require.NoError(t, robot.SendAsync(NewTextMsg("queued")))
+	assert.ErrorIs(t, robot.SendAsync(NewTextMsg("overflow")), ErrQueueFull)
+	assert.Error(t, robot.SendAsync(nil))
+
+	close(release)
+	require.NoError(t, robot.Close(context.Background()))
+	assert.ErrorIs(t, robot.SendAsync(NewTextMsg("late")), ErrRobotClosed)
+	assert.NoError(t, robot.Close(context.Background()))
+}
+
+func TestRobot_Close_ContextExpires(t *testing.T) {
+	stop := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-stop:
+		}
+	}))
+	defer srv.Close()
+	defer close(stop)
+
+	var mu sync.Mutex
+	var errs []error
+	robot := NewRobot("test_token").
+		WithClient(serverClient(srv)).
+		WithErrorHandler(func(_ Message, err error) {
+			mu.Lock()
+			errs = append(errs, err)
+			mu.Unlock()
+		})
+
+	require.NoError(t, robot.SendAsync(NewTextMsg("stuck")))
+	require.NoError(t, robot.SendAsync(NewTextMsg("pending")))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := robot.Close(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, errs, 2)
+	assert.ErrorIs(t, errs[1], context.Canceled)
+}
+
+func TestRobot_SendAsync_BadBaseURLNotRetried(t *testing.T) {
+	var retries atomic.Int64
+	var gotErr error
+	robot := NewRobot("test_token").
+		WithBaseURL("http://[::1").
+		WithRetry(3, func(int) time.Duration {
+			retries.Add(1)
+			return 0
+		}).
+		WithErrorHandler(func(_ Message, err error) { gotErr = err })
+
+	require.NoError(t, robot.SendAsync(NewTextMsg("hello")))
+	require.NoError(t, robot.Close(context.Background()))
+
+	assert.Zero(t, retries.Load())
+	assert.ErrorContains(t, gotErr, "parse base url")
+}
+
+func TestRobot_SendAsync_RateLimitRetriedOnce(t *testing.T) {
+	var calls atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		_, _ = io.WriteString(w, `{"errcode":130101,"errmsg":"send too fast"}`)
+	}))
+	defer srv.Close()
+
+	var gotErr error
+	robot := NewRobot("test_token").
+		WithClient(serverClient(srv)).
+		WithRetry(3, nil).
+		WithRateLimitRetry(2, time.Millisecond).
+		WithErrorHandler(func(_ Message, err error) { gotErr = err })
+
+	require.NoError(t, robot.SendAsync(NewTextMsg("hello")))
+	require.NoError(t, robot.Close(context.Background()))
+
+	assert.Equal(t, int64(3), calls.Load())
+	assert.ErrorIs(t, gotErr, ErrRateLimited)
+}
+
+func TestRobot_Close_WithoutSendAsync(t *testing.T) {
+	robot := NewRobot("test_token")
+	assert.NoError(t, robot.Close(context.Background()))
+	assert.ErrorIs(t, robot.SendAsync(NewTextMsg("late")), ErrRobotClosed)
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"server error", &statusError{code: http.StatusBadGateway}, true},
+		{"too many requests", &statusError{code: http.StatusTooManyRequests}, true},
+		{"client error", &statusError{code: http.StatusForbidden}, false},
+		{"rate limited", &APIError{ErrCode: rateLimitErrCode}, false},
+		{"keyword mismatch", &APIError{ErrCode: 310000}, false},
+		{"network", &url.Error{Op: "Post", Err: errors.New("connection reset")}, true},
+		{"bad base url", fmt.Errorf("parse base url: %w", &url.Error{Op: "parse", URL: "http://[::1", Err: errors.New("missing ']'")}), false},
+		{"validation", errors.New("send dingtalk message: access token is empty"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isTransient(tt.err))
+		})
+	}
+}
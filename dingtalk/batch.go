@@ -0,0 +1,34 @@
+package dingtalk
+
+import "context"
+
+// SendBatch sends msgs one after another, each with the default timeout, and
+// keeps going after failures. The returned slice is aligned with msgs: errs[i]
+// is nil when msgs[i] was delivered. Sends are paced by the robot's rate
+// limit, so a batch larger than the limit takes more than a minute.
+func (r *Robot) SendBatch(msgs []Message) []error {
+	errs := make([]error, len(msgs))
+	for i, msg := range msgs {
+		errs[i] = r.Send(msg)
+	}
+	return errs
+}
+
+// SendBatchWithContext is like SendBatch but sends every message under ctx.
+// Once ctx ends, the remaining messages are not sent and their entries hold
+// ctx.Err(). A nil context is treated as context.Background.
+func (r *Robot) SendBatchWithContext(ctx context.Context, msgs []Message) []error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	errs := make([]error, len(msgs))
+	for i, msg := range msgs {
+		if err := ctx.Err(); err != nil {
+			errs[i] = err
+			continue
+		}
+		errs[i] = r.SendWithContext(ctx, msg)
+	}
+	return errs
+}
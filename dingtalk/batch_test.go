@@ -0,0 +1,63 @@
+package dingtalk
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRobot_SendBatch(t *testing.T) {
+	var calls atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		_, _ = io.WriteString(w, `{"errcode":0,"errmsg":"ok"}`)
+	}))
+	defer srv.Close()
+
+	robot := NewRobot("test_token").WithClient(serverClient(srv))
+	errs := robot.SendBatch([]Message{
+		NewTextMsg("first"),
+		NewTextMsg(""),
+		NewTextMsg("third"),
+	})
+
+	require.Len(t, errs, 3)
+	assert.NoError(t, errs[0])
+	assert.ErrorIs(t, errs[1], ErrInvalidMessage)
+	assert.NoError(t, errs[2])
+	assert.Equal(t, int64(2), calls.Load())
+
+	assert.Empty(t, robot.SendBatch(nil))
+}
+
+func TestRobot_SendBatchWithContext_StopsWhenContextEnds(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var calls atomic.Int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			cancel()
+		}
+		_, _ = io.WriteString(w, `{"errcode":0,"errmsg":"ok"}`)
+	}))
+	defer srv.Close()
+
+	robot := NewRobot("test_token").WithClient(serverClient(srv))
+	errs := robot.SendBatchWithContext(ctx, []Message{
+		NewTextMsg("first"),
+		NewTextMsg("second"),
+		NewTextMsg("third"),
+	})
+
+	require.Len(t, errs, 3)
+	assert.ErrorIs(t, errs[1], context.Canceled)
+	assert.ErrorIs(t, errs[2], context.Canceled)
+	assert.Equal(t, int64(1), calls.Load())
+}
@@ -0,0 +1,136 @@
+package dingtalk
+
+import "strings"
+
+var (
+	markdownEscaper = strings.NewReplacer(
+		`\`, `\\`,
+		"`", "\\`",
+		`*`, `\*`,
+		`_`, `\_`,
+		`[`, `\[`,
+		`]`, `\]`,
+		`#`, `\#`,
+		`>`, `\>`,
+		"\r\n", " ",
+		"\n", " ",
+	)
+	markdownURLEscaper = strings.NewReplacer(
+		" ", "%20",
+		"(", "%28",
+		")", "%29",
+	)
+)
+
+// MarkdownBuilder assembles text for a MarkdownMsg using the subset of
+// markdown DingTalk renders. Text passed to its methods is escaped, so
+// characters such as * or [ appear literally, and line breaks inside inline
+// text become spaces. Use Raw to append markdown as is.
+type MarkdownBuilder struct {
+	sb strings.Builder
+}
+
+// NewMarkdownBuilder returns an empty builder.
+func NewMarkdownBuilder() *MarkdownBuilder {
+	return &MarkdownBuilder{}
+}
+
+// H1 appends a level 1 heading on its own paragraph.
+func (b *MarkdownBuilder) H1(text string) *MarkdownBuilder {
+	return b.heading("# ", text)
+}
+
+// H2 appends a level 2 heading on its own paragraph.
+func (b *MarkdownBuilder) H2(text string) *MarkdownBuilder {
+	return b.heading("## ", text)
+}
+
+// H3 appends a level 3 heading on its own paragraph.
+func (b *MarkdownBuilder) H3(text string) *MarkdownBuilder {
+	return b.heading("### ", text)
+}
+
+func (b *MarkdownBuilder) heading(prefix, text string) *MarkdownBuilder {
+	b.sb.WriteString(prefix)
+	b.sb.WriteString(markdownEscaper.Replace(text))
+	b.sb.WriteString("\n\n")
+	return b
+}
+
+// Text appends plain inline text.
+func (b *MarkdownBuilder) Text(text string) *MarkdownBuilder {
+	b.sb.WriteString(markdownEscaper.Replace(text))
+	return b
+}
+
+// Bold appends inline bold text.
+func (b *MarkdownBuilder) Bold(text string) *MarkdownBuilder {
+	b.sb.WriteString("**")
+	b.sb.WriteString(markdownEscaper.Replace(text))
+	b.sb.WriteString("**")
+	return b
+}
+
+// Italic appends inline italic text.
+func (b *MarkdownBuilder) Italic(text string) *MarkdownBuilder {
+	b.sb.WriteString("*")
+	b.sb.WriteString(markdownEscaper.Replace(text))
+	b.sb.WriteString("*")
+	return b
+}
+
+// Link appends an inline link. Spaces and parentheses in url are
+// percent-encoded so they cannot end the link early.
+func (b *MarkdownBuilder) Link(text, url string) *MarkdownBuilder {
+	b.sb.WriteString("[")
+	b.sb.WriteString(markdownEscaper.Replace(text))
+	b.sb.WriteString("](")
+	b.sb.WriteString(markdownURLEscaper.Replace(url))
+	b.sb.WriteString(")")
+	return b
+}
+
+// Mention appends "@id" for a mobile number or user ID. DingTalk only
+// highlights it when the message also lists id in its At field, for example
+// through MarkdownMsg.WithAtMobiles.
+func (b *MarkdownBuilder) Mention(id string) *MarkdownBuilder {
+	b.sb.WriteString("@")
+	b.sb.WriteString(id)
+	return b
+}
+
+// ListItem appends an unordered list item on its own line. Follow the last
+// item with Newline to end the list.
+func (b *MarkdownBuilder) ListItem(text string) *MarkdownBuilder {
+	b.sb.WriteString("- ")
+	b.sb.WriteString(markdownEscaper.Replace(text))
+	b.sb.WriteString("\n")
+	return b
+}
+
+// Quote appends a block quote on its own paragraph.
+func (b *MarkdownBuilder) Quote(text string) *MarkdownBuilder {
+	b.sb.WriteString("> ")
+	b.sb.WriteString(markdownEscaper.Replace(text))
+	b.sb.WriteString("\n\n")
+	return b
+}
+
+// Newline ends the current paragraph. DingTalk needs a blank line to break
+// lines, so a single "\n" is not enough.
+func (b *MarkdownBuilder) Newline() *MarkdownBuilder {
+	b.sb.WriteString("\n\n")
+	return b
+}
+
+// Raw appends markdown without escaping.
+func (b *MarkdownBuilder) Raw(markdown string) *MarkdownBuilder {
+	b.sb.WriteString(markdown)
+	return b
+}
+
+// Build returns the accumulated markdown, without trailing line breaks, ready
+// for NewMarkdownMsg.
+func (b *MarkdownBuilder) Build() string {
+	return strings.TrimRight(b.sb.String(), "\n")
+}
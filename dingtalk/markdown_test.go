@@ -0,0 +1,64 @@
+package dingtalk
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkdownBuilder(t *testing.T) {
+	text := NewMarkdownBuilder().
+		H1("Deploy finished").
+		Text("Service ").Bold("api").Text(" is live, see ").Link("the dashboard", "https://example.com/d?id=1").
+		Newline().
+		H2("Changes").
+		ListItem("Fix login").
+		ListItem("Add metrics").
+		Newline().
+		Quote("Rolled out by CI").
+		H3("Owner").
+		Mention("13800000000").
+		Build()
+
+	assert.Equal(t, "# Deploy finished\n\n"+
+		"Service **api** is live, see [the dashboard](https://example.com/d?id=1)\n\n"+
+		"## Changes\n\n"+
+		"- Fix login\n"+
+		"- Add metrics\n\n\n"+
+		"> Rolled out by CI\n\n"+
+		"### Owner\n\n"+
+		"@13800000000", text)
+
+	msg := NewMarkdownMsg("Deploy", text).WithAtMobiles([]string{"13800000000"})
+	require.NoError(t, msg.Validate())
+	payload, err := msg.Payload()
+	require.NoError(t, err)
+
+	var decoded MarkdownMsg
+	require.NoError(t, json.Unmarshal(payload, &decoded))
+	assert.Equal(t, text, decoded.Markdown.Text)
+}
+
+func TestMarkdownBuilder_Escapes(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"emphasis", NewMarkdownBuilder().Text("2*3 = 6_x").Build(), `2\*3 = 6\_x`},
+		{"brackets", NewMarkdownBuilder().Bold("[draft]").Build(), `**\[draft\]**`},
+		{"heading and quote", NewMarkdownBuilder().ListItem("# not > heading").Build(), `- \# not \> heading`},
+		{"backslash and code", NewMarkdownBuilder().Italic("a\\b `c`").Build(), "*a\\\\b \\`c\\`*"},
+		{"line breaks", NewMarkdownBuilder().H2("two\nlines").Build(), "## two lines"},
+		{"link", NewMarkdownBuilder().Link("a]b", "https://x.io/a b(1)").Build(), `[a\]b](https://x.io/a%20b%281%29)`},
+		{"raw", NewMarkdownBuilder().Raw("**kept**").Build(), "**kept**"},
+		{"empty", NewMarkdownBuilder().Build(), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.got)
+		})
+	}
+}
@@ -3,6 +3,7 @@ package dingtalk
 import (
 	"encoding/json"
 	"slices"
+	"strings"
 )
 
 const (
@@ -11,11 +12,13 @@ const (
 	MsgTypeLink       = "link"
 	MsgTypeActionCard = "actionCard"
 	MsgTypeFeedCard   = "feedCard"
+	MsgTypeImage      = "image"
 )
 
+// Button layouts for multi-button action cards, as DingTalk encodes them.
 const (
-	BtnOrientationHorizontal = "0"
-	BtnOrientationVertical   = "1"
+	BtnOrientationVertical   = "0"
+	BtnOrientationHorizontal = "1"
 )
 
 // Message is implemented by DingTalk robot message payloads.
@@ -25,6 +28,7 @@ type Message interface {
 
 type At struct {
 	AtMobiles []string `json:"atMobiles,omitempty"`
+	AtUserIds []string `json:"atUserIds,omitempty"`
 	IsAtAll   bool     `json:"isAtAll"`
 }
 
@@ -47,6 +51,12 @@ func (m *TextMsg) WithAtMobiles(mobiles []string) *TextMsg {
 	return m
 }
 
+// WithAtUserIds mentions users by DingTalk user ID.
+func (m *TextMsg) WithAtUserIds(userIDs []string) *TextMsg {
+	m.At.AtUserIds = slices.Clone(userIDs)
+	return m
+}
+
 func (m *TextMsg) WithIsAtAll(isAll bool) *TextMsg {
 	m.At.IsAtAll = isAll
 	return m
@@ -77,13 +87,40 @@ func (m *MarkdownMsg) WithAtMobiles(mobiles []string) *MarkdownMsg {
 	return m
 }
 
+// WithAtUserIds mentions users by DingTalk user ID.
+func (m *MarkdownMsg) WithAtUserIds(userIDs []string) *MarkdownMsg {
+	m.At.AtUserIds = slices.Clone(userIDs)
+	return m
+}
+
 func (m *MarkdownMsg) WithIsAtAll(isAll bool) *MarkdownMsg {
 	m.At.IsAtAll = isAll
 	return m
 }
 
+// Payload marshals the message. DingTalk only highlights a markdown mention
+// when the text also contains "@<mobile>" or "@<userId>", so any mentioned
+// mobile or user ID missing from the text is appended on a final line. m is
+// not modified.
 func (m *MarkdownMsg) Payload() ([]byte, error) {
-	return json.Marshal(m)
+	out := *m
+	out.Markdown.Text = appendMentions(m.Markdown.Text, m.At)
+	return json.Marshal(&out)
+}
+
+func appendMentions(text string, at At) string {
+	var tokens []string
+	for _, id := range slices.Concat(at.AtMobiles, at.AtUserIds) {
+		token := "@" + id
+		if id == "" || strings.Contains(text, token) || slices.Contains(tokens, token) {
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+	if len(tokens) == 0 {
+		return text
+	}
+	return text + "\n\n" + strings.Join(tokens, " ")
 }
 
 type LinkMsg struct {
@@ -139,14 +176,19 @@ func NewSingleActionCard(title, text, singleTitle, singleURL string) *ActionCard
 	return m
 }
 
+// NewMultiActionCard returns a card with one button per entry in btns, stacked
+// vertically. Use WithBtnOrientation to lay them out side by side.
 func NewMultiActionCard(title, text string, btns []ActionCardBtn) *ActionCardMsg {
 	m := &ActionCardMsg{MsgType: MsgTypeActionCard}
 	m.ActionCard.Title = title
 	m.ActionCard.Text = text
+	m.ActionCard.BtnOrientation = BtnOrientationVertical
 	m.ActionCard.Btns = slices.Clone(btns)
 	return m
 }
 
+// WithBtnOrientation sets the button layout to BtnOrientationVertical or
+// BtnOrientationHorizontal; other values are ignored.
 func (m *ActionCardMsg) WithBtnOrientation(orientation string) *ActionCardMsg {
 	if orientation == BtnOrientationHorizontal || orientation == BtnOrientationVertical {
 		m.ActionCard.BtnOrientation = orientation
@@ -177,14 +219,45 @@ func NewFeedCardMsg(links []FeedLink) *FeedCardMsg {
 	return m
 }
 
+// AddLink appends a link and returns m for chaining. Links are not checked
+// here; Validate, which Send calls, rejects links missing a message or picture
+// URL and cards with more than 12 links.
+func (m *FeedCardMsg) AddLink(title, messageURL, picURL string) *FeedCardMsg {
+	m.FeedCard.Links = append(m.FeedCard.Links, FeedLink{
+		Title:      title,
+		MessageURL: messageURL,
+		PicURL:     picURL,
+	})
+	return m
+}
+
 func (m *FeedCardMsg) Payload() ([]byte, error) {
 	return json.Marshal(m)
 }
 
+// ImageMsg posts a single picture by URL.
+type ImageMsg struct {
+	MsgType string `json:"msgtype"`
+	Image   struct {
+		PicURL string `json:"picURL"`
+	} `json:"image"`
+}
+
+func NewImageMsg(picURL string) *ImageMsg {
+	m := &ImageMsg{MsgType: MsgTypeImage}
+	m.Image.PicURL = picURL
+	return m
+}
+
+func (m *ImageMsg) Payload() ([]byte, error) {
+	return json.Marshal(m)
+}
+
 var (
 	_ Message = (*TextMsg)(nil)
 	_ Message = (*MarkdownMsg)(nil)
 	_ Message = (*LinkMsg)(nil)
 	_ Message = (*ActionCardMsg)(nil)
 	_ Message = (*FeedCardMsg)(nil)
+	_ Message = (*ImageMsg)(nil)
 )
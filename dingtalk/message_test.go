@@ -85,6 +85,47 @@ func TestMarkdownMsg_Payload(t *testing.T) {
 	assert.Equal(t, "Title", result["markdown"].(map[string]any)["title"])
 }
 
+func TestMarkdownMsg_PayloadAppendsMentions(t *testing.T) {
+	msg := NewMarkdownMsg("Title", "Deploy failed, @13800138000 please check").
+		WithAtMobiles([]string{"13800138000", "13900139000"}).
+		WithAtUserIds([]string{"user001", "13900139000"})
+
+	payload, err := msg.Payload()
+	require.NoError(t, err)
+
+	var result struct {
+		Markdown struct {
+			Text string `json:"text"`
+		} `json:"markdown"`
+		At At `json:"at"`
+	}
+	require.NoError(t, json.Unmarshal(payload, &result))
+
+	assert.Equal(t, "Deploy failed, @13800138000 please check\n\n@13900139000 @user001", result.Markdown.Text)
+	assert.Equal(t, []string{"13800138000", "13900139000"}, result.At.AtMobiles)
+	assert.Equal(t, []string{"user001", "13900139000"}, result.At.AtUserIds)
+	assert.Equal(t, "Deploy failed, @13800138000 please check", msg.Markdown.Text)
+}
+
+func TestMarkdownMsg_PayloadWithoutMentions(t *testing.T) {
+	payload, err := NewMarkdownMsg("Title", "Content").Payload()
+	require.NoError(t, err)
+	assert.Contains(t, string(payload), `"text":"Content"`)
+	assert.NotContains(t, string(payload), "atUserIds")
+}
+
+func TestTextMsg_WithAtUserIds(t *testing.T) {
+	ids := []string{"user001"}
+	msg := NewTextMsg("Hello").WithAtUserIds(ids)
+	ids[0] = "changed"
+
+	assert.Equal(t, []string{"user001"}, msg.At.AtUserIds)
+
+	payload, err := msg.Payload()
+	require.NoError(t, err)
+	assert.Contains(t, string(payload), `"atUserIds":["user001"]`)
+}
+
 func TestNewLinkMsg(t *testing.T) {
 	msg := NewLinkMsg("Title", "Description", "https://example.com")
 
@@ -139,6 +180,7 @@ func TestNewMultiActionCard(t *testing.T) {
 	assert.Equal(t, "Text", msg.ActionCard.Text)
 	assert.Len(t, msg.ActionCard.Btns, 2)
 	assert.Equal(t, "Button1", msg.ActionCard.Btns[0].Title)
+	assert.Equal(t, BtnOrientationVertical, msg.ActionCard.BtnOrientation)
 }
 
 func TestActionCardMsg_WithBtnOrientation(t *testing.T) {
@@ -165,6 +207,29 @@ func TestActionCardMsg_Payload(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.Equal(t, MsgTypeActionCard, result["msgtype"])
+	assert.NotContains(t, result["actionCard"], "btnOrientation")
+}
+
+func TestActionCardMsg_Payload_Multi(t *testing.T) {
+	msg := NewMultiActionCard("Title", "Text", []ActionCardBtn{
+		{Title: "Yes", ActionURL: "https://example.com/y"},
+		{Title: "No", ActionURL: "https://example.com/n"},
+	}).WithBtnOrientation(BtnOrientationHorizontal)
+
+	payload, err := msg.Payload()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"msgtype": "actionCard",
+		"actionCard": {
+			"title": "Title",
+			"text": "Text",
+			"btnOrientation": "1",
+			"btns": [
+				{"title": "Yes", "actionURL": "https://example.com/y"},
+				{"title": "No", "actionURL": "https://example.com/n"}
+			]
+		}
+	}`, string(payload))
 }
 
 func TestNewFeedCardMsg(t *testing.T) {
@@ -181,6 +246,45 @@ func TestNewFeedCardMsg(t *testing.T) {
 	assert.Equal(t, "Link1", msg.FeedCard.Links[0].Title)
 }
 
+func TestFeedCardMsg_AddLink(t *testing.T) {
+	links := []FeedLink{{Title: "Link1", MessageURL: "https://example.com/1", PicURL: "https://example.com/1.png"}}
+	msg := NewFeedCardMsg(links)
+
+	result := msg.
+		AddLink("Link2", "https://example.com/2", "https://example.com/2.png").
+		AddLink("Link3", "https://example.com/3", "https://example.com/3.png")
+
+	assert.Same(t, msg, result)
+	require.Len(t, msg.FeedCard.Links, 3)
+	assert.Equal(t, FeedLink{Title: "Link3", MessageURL: "https://example.com/3", PicURL: "https://example.com/3.png"}, msg.FeedCard.Links[2])
+	assert.Len(t, links, 1)
+	assert.NoError(t, msg.Validate())
+}
+
+func TestFeedCardMsg_Validate(t *testing.T) {
+	assert.NoError(t, NewFeedCardMsg(nil).AddLink("a", "https://example.com", "https://example.com/a.png").Validate())
+
+	err := NewFeedCardMsg(nil).
+		AddLink("a", "https://example.com", "https://example.com/a.png").
+		AddLink("b", "https://example.com", "").
+		Validate()
+	assert.ErrorIs(t, err, ErrInvalidMessage)
+	assert.ErrorContains(t, err, "link 1")
+
+	err = NewFeedCardMsg(nil).AddLink("a", "", "https://example.com/a.png").Validate()
+	assert.ErrorIs(t, err, ErrInvalidMessage)
+
+	full := NewFeedCardMsg(nil)
+	for range maxFeedLinks {
+		full.AddLink("a", "https://example.com", "https://example.com/a.png")
+	}
+	assert.NoError(t, full.Validate())
+
+	err = full.AddLink("a", "https://example.com", "https://example.com/a.png").Validate()
+	assert.ErrorIs(t, err, ErrInvalidMessage)
+	assert.ErrorContains(t, err, "13 links")
+}
+
 func TestFeedCardMsg_Payload(t *testing.T) {
 	links := []FeedLink{
 		{Title: "Link1", MessageURL: "https://example.com/1", PicURL: "https://example.com/pic1.jpg"},
@@ -197,3 +301,12 @@ func TestFeedCardMsg_Payload(t *testing.T) {
 
 	assert.Equal(t, MsgTypeFeedCard, result["msgtype"])
 }
+
+func TestImageMsg_Payload(t *testing.T) {
+	msg := NewImageMsg("https://example.com/a.png")
+	assert.Equal(t, MsgTypeImage, msg.MsgType)
+
+	payload, err := msg.Payload()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"msgtype":"image","image":{"picURL":"https://example.com/a.png"}}`, string(payload))
+}
@@ -0,0 +1,95 @@
+package dingtalk
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultRateLimit is the documented DingTalk robot limit per minute.
+	defaultRateLimit        = 20
+	defaultRateLimitRetries = 2
+	defaultRateLimitDelay   = time.Second
+)
+
+// WithRateLimit paces sends to perMinute messages per minute with a token
+// bucket that allows bursts of up to perMinute messages. Sends wait for a
+// token or until their context ends. The default is 20, the DingTalk robot
+// limit; perMinute <= 0 disables pacing.
+func (r *Robot) WithRateLimit(perMinute int) *Robot {
+	r.limiter = newRateLimiter(perMinute)
+	return r
+}
+
+// WithRateLimitRetry sets how many times a send is repeated after DingTalk
+// still answers with the rate-limit errcode, and the delay before each retry.
+// Negative values are ignored.
+func (r *Robot) WithRateLimitRetry(retries int, delay time.Duration) *Robot {
+	if retries >= 0 {
+		r.rateLimitRetries = retries
+	}
+	if delay >= 0 {
+		r.rateLimitDelay = delay
+	}
+	return r
+}
+
+// rateLimiter is a token bucket refilled continuously at capacity per minute.
+type rateLimiter struct {
+	mu       sync.Mutex
+	capacity float64
+	tokens   float64
+	every    time.Duration
+	last     time.Time
+	now      func() time.Time
+}
+
+func newRateLimiter(perMinute int) *rateLimiter {
+	if perMinute <= 0 {
+		return nil
+	}
+	return &rateLimiter{
+		capacity: float64(perMinute),
+		tokens:   float64(perMinute),
+		every:    time.Minute / time.Duration(perMinute),
+		now:      time.Now,
+	}
+}
+
+// wait takes one token, blocking until one is available or ctx ends.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	for {
+		delay := l.reserve()
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// reserve takes a token and returns 0, or returns how long until one is free.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens += float64(now.Sub(l.last)) / float64(l.every)
+		l.tokens = min(l.tokens, l.capacity)
+	}
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+	return time.Duration((1 - l.tokens) * float64(l.every))
+}
@@ -0,0 +1,120 @@
+package dingtalk
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_Reserve(t *testing.T) {
+	l := newRateLimiter(2)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+
+	assert.Zero(t, l.reserve())
+	assert.Zero(t, l.reserve())
+	assert.Equal(t, 30*time.Second, l.reserve())
+
+	now = now.Add(15 * time.Second)
+	assert.Equal(t, 15*time.Second, l.reserve())
+
+	now = now.Add(15 * time.Second)
+	assert.Zero(t, l.reserve())
+
+	// Idle time refills the bucket only up to its capacity.
+	now = now.Add(time.Hour)
+	assert.Zero(t, l.reserve())
+	assert.Zero(t, l.reserve())
+	assert.Equal(t, 30*time.Second, l.reserve())
+}
+
+func TestRateLimiter_WaitHonorsContext(t *testing.T) {
+	l := newRateLimiter(1)
+	require.NoError(t, l.wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, l.wait(ctx), context.DeadlineExceeded)
+}
+
+func TestRobot_WithRateLimit(t *testing.T) {
+	robot := NewRobot("test_token")
+	require.NotNil(t, robot.limiter)
+	assert.Equal(t, float64(defaultRateLimit), robot.limiter.capacity)
+
+	assert.Same(t, robot, robot.WithRateLimit(0))
+	assert.Nil(t, robot.limiter)
+
+	robot.WithRateLimit(60)
+	assert.Equal(t, time.Second, robot.limiter.every)
+}
+
+func TestRobot_Send_PacesWithRateLimit(t *testing.T) {
+	robot := NewRobot("test_token").
+		WithRateLimit(1).
+		WithClient(&http.Client{
+			Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+				return jsonResponse(http.StatusOK, `{"errcode":0,"errmsg":"ok"}`), nil
+			}),
+		})
+
+	require.NoError(t, robot.SendWithContext(context.Background(), NewTextMsg("first")))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := robot.SendWithContext(ctx, NewTextMsg("second"))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRobot_Send_RetriesRateLimitedResponse(t *testing.T) {
+	var calls atomic.Int64
+	robot := NewRobot("test_token").
+		WithRateLimitRetry(2, time.Millisecond).
+		WithClient(&http.Client{
+			Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+				if calls.Add(1) < 3 {
+					return jsonResponse(http.StatusOK, `{"errcode":130101,"errmsg":"send too fast"}`), nil
+				}
+				return jsonResponse(http.StatusOK, `{"errcode":0,"errmsg":"ok"}`), nil
+			}),
+		})
+
+	require.NoError(t, robot.SendWithContext(context.Background(), NewTextMsg("hello")))
+	assert.Equal(t, int64(3), calls.Load())
+}
+
+func TestRobot_Send_RateLimitedAfterRetries(t *testing.T) {
+	var calls atomic.Int64
+	robot := NewRobot("test_token").
+		WithRateLimitRetry(1, time.Millisecond).
+		WithClient(&http.Client{
+			Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+				calls.Add(1)
+				return jsonResponse(http.StatusOK, `{"errcode":130101,"errmsg":"send too fast"}`), nil
+			}),
+		})
+
+	err := robot.SendWithContext(context.Background(), NewTextMsg("hello"))
+	assert.ErrorIs(t, err, ErrRateLimited)
+	assert.ErrorIs(t, err, ErrUnexpectedResponse)
+	assert.Equal(t, int64(2), calls.Load())
+}
+
+func TestRobot_Send_OtherErrCodesAreNotRateLimited(t *testing.T) {
+	var calls atomic.Int64
+	robot := NewRobot("test_token").WithClient(&http.Client{
+		Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			calls.Add(1)
+			return jsonResponse(http.StatusOK, `{"errcode":310000,"errmsg":"keywords not in content"}`), nil
+		}),
+	})
+
+	err := robot.SendWithContext(context.Background(), NewTextMsg("hello"))
+	assert.NotErrorIs(t, err, ErrRateLimited)
+	assert.Equal(t, int64(1), calls.Load())
+}
@@ -14,6 +14,8 @@ import (
 	"net/url"
 	"sync"
 	"time"
+
+	"github.com/onnttf/kit/concurrent"
 )
 
 var (
@@ -21,11 +23,29 @@ var (
 	ErrUnexpectedStatus = errors.New("unexpected http status")
 	// ErrUnexpectedResponse indicates that DingTalk returned a non-zero error code.
 	ErrUnexpectedResponse = errors.New("unexpected response")
+	// ErrRateLimited indicates that the robot exceeded its send rate (errcode 130101).
+	ErrRateLimited = errors.New("rate limited")
+	// ErrInvalidToken indicates that the access token does not exist (errcode 300001).
+	ErrInvalidToken = errors.New("invalid access token")
+	// ErrSecurityRejected indicates that the message failed the robot's security
+	// settings: missing keyword, bad signature, or IP not allowed (errcode 310000).
+	ErrSecurityRejected = errors.New("security check failed")
+)
+
+const (
+	rateLimitErrCode        = 130101
+	invalidTokenErrCode     = 300001
+	securityRejectedErrCode = 310000
 )
 
+const sendTimeout = 5 * time.Second
+
+// defaultBaseURL is the official DingTalk robot webhook endpoint.
+const defaultBaseURL = "https://oapi.dingtalk.com/robot/send"
+
 var getDefaultClient = sync.OnceValue(func() *http.Client {
 	return &http.Client{
-		Timeout:   5 * time.Second,
+		Timeout:   sendTimeout,
 		Transport: defaultTransport(),
 	}
 })
@@ -34,11 +54,50 @@ var getDefaultClient = sync.OnceValue(func() *http.Client {
 type Robot struct {
 	accessToken string
 	secret      string
+	baseURL     string
 	httpClient  *http.Client
+
+	limiter          *rateLimiter
+	rateLimitRetries int
+	rateLimitDelay   time.Duration
+
+	async asyncQueue
 }
 
+// NewRobot returns a robot for accessToken. All robots built by NewRobot share
+// one package-level client and keep-alive pool, so creating many robots does
+// not open extra connections. Use NewRobotWithTransport or WithClient to
+// supply a tuned transport instead.
 func NewRobot(accessToken string) *Robot {
-	return &Robot{accessToken: accessToken, httpClient: getDefaultClient()}
+	return newRobot(accessToken, getDefaultClient())
+}
+
+// NewRobotWithTransport returns a robot whose client uses transport with the
+// default send timeout. Pass the same transport to every robot that should
+// share connections; a nil transport falls back to the shared default client.
+func NewRobotWithTransport(accessToken string, transport http.RoundTripper) *Robot {
+	if transport == nil {
+		return NewRobot(accessToken)
+	}
+	return newRobot(accessToken, &http.Client{Timeout: sendTimeout, Transport: transport})
+}
+
+func newRobot(accessToken string, client *http.Client) *Robot {
+	return &Robot{
+		accessToken: accessToken,
+		baseURL:     defaultBaseURL,
+		httpClient:  client,
+
+		limiter:          newRateLimiter(defaultRateLimit),
+		rateLimitRetries: defaultRateLimitRetries,
+		rateLimitDelay:   defaultRateLimitDelay,
+
+		async: asyncQueue{
+			size:     defaultQueueSize,
+			maxRetry: defaultMaxRetry,
+			backoff:  concurrent.ExponentialBackoff(defaultRetryBase, defaultRetryMaxDelay),
+		},
+	}
 }
 
 func (r *Robot) WithSecret(secret string) *Robot {
@@ -46,6 +105,8 @@ func (r *Robot) WithSecret(secret string) *Robot {
 	return r
 }
 
+// WithClient replaces the HTTP client. Robots given clients that share a
+// *http.Transport also share its connection pool. A nil client is ignored.
 func (r *Robot) WithClient(client *http.Client) *Robot {
 	if client != nil {
 		r.httpClient = client
@@ -53,72 +114,136 @@ func (r *Robot) WithClient(client *http.Client) *Robot {
 	return r
 }
 
+// WithBaseURL replaces the webhook endpoint, for example to go through a proxy
+// or to reach a test server. The access_token, timestamp, and sign query
+// parameters are still added to every request. An empty URL is ignored.
+func (r *Robot) WithBaseURL(baseURL string) *Robot {
+	if baseURL != "" {
+		r.baseURL = baseURL
+	}
+	return r
+}
+
 // Send posts msg using a background context with the default timeout.
 func (r *Robot) Send(msg Message) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
 	defer cancel()
 	return r.SendWithContext(ctx, msg)
 }
 
 // A nil context is treated as context.Background.
-func (r *Robot) SendWithContext(ctx context.Context, msg Message) (err error) {
+func (r *Robot) SendWithContext(ctx context.Context, msg Message) error {
+	_, err := r.SendAndResponseWithContext(ctx, msg)
+	return err
+}
+
+// SendResponse is DingTalk's answer to a successful send.
+type SendResponse struct {
+	ErrCode int
+	ErrMsg  string
+	// Body is the raw response body, for fields this package does not parse.
+	Body []byte
+}
+
+// SendAndResponse is like Send but also returns DingTalk's response.
+func (r *Robot) SendAndResponse(msg Message) (*SendResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+	return r.SendAndResponseWithContext(ctx, msg)
+}
+
+// SendAndResponseWithContext is like SendWithContext but also returns
+// DingTalk's response. The response is nil whenever the error is not.
+func (r *Robot) SendAndResponseWithContext(ctx context.Context, msg Message) (*SendResponse, error) {
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	if r.accessToken == "" {
-		return errors.New("send dingtalk message: access token is empty")
+		return nil, errors.New("send dingtalk message: access token is empty")
 	}
 	if r.httpClient == nil {
-		return errors.New("send dingtalk message: http client is nil")
+		return nil, errors.New("send dingtalk message: http client is nil")
 	}
 	if msg == nil {
-		return errors.New("send dingtalk message: message is nil")
+		return nil, errors.New("send dingtalk message: message is nil")
+	}
+	if err := ValidateMessage(msg); err != nil {
+		return nil, err
 	}
 
 	payload, err := msg.Payload()
 	if err != nil {
-		return fmt.Errorf("marshal message: %w", err)
+		return nil, fmt.Errorf("marshal message: %w", err)
 	}
 	if len(payload) == 0 {
-		return errors.New("send dingtalk message: payload is empty")
+		return nil, errors.New("send dingtalk message: payload is empty")
+	}
+
+	for attempt := 0; ; attempt++ {
+		if r.limiter != nil {
+			if err := r.limiter.wait(ctx); err != nil {
+				return nil, fmt.Errorf("wait for rate limit: %w", err)
+			}
+		}
+
+		resp, err := r.post(ctx, payload)
+		if err == nil || !errors.Is(err, ErrRateLimited) || attempt >= r.rateLimitRetries {
+			return resp, err
+		}
+
+		timer := time.NewTimer(r.rateLimitDelay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		}
+	}
+}
+
+// post sends one signed webhook request carrying payload.
+func (r *Robot) post(ctx context.Context, payload []byte) (sendResp *SendResponse, err error) {
+	webhookURL, err := url.Parse(r.baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse base url: %w", err)
 	}
 
 	timestamp := time.Now().UnixMilli()
-	values := url.Values{}
+	values := webhookURL.Query()
 	values.Set("access_token", r.accessToken)
 	if r.secret != "" {
 		sign, err := r.calculateSign(timestamp)
 		if err != nil {
-			return fmt.Errorf("calculate sign: %w", err)
+			return nil, fmt.Errorf("calculate sign: %w", err)
 		}
 		values.Set("timestamp", fmt.Sprintf("%d", timestamp))
 		values.Set("sign", sign)
 	}
-	webhookURL := "https://oapi.dingtalk.com/robot/send?" + values.Encode()
+	webhookURL.RawQuery = values.Encode()
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewBuffer(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL.String(), bytes.NewBuffer(payload))
 	if err != nil {
-		return fmt.Errorf("create request: %w", err)
+		return nil, fmt.Errorf("create request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json;charset=utf-8")
 
 	resp, err := r.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("send request: %w", err)
+		return nil, fmt.Errorf("send request: %w", err)
 	}
 	defer func() {
 		if closeErr := resp.Body.Close(); err == nil && closeErr != nil {
-			err = fmt.Errorf("close response body: %w", closeErr)
+			sendResp, err = nil, fmt.Errorf("close response body: %w", closeErr)
 		}
 	}()
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("%w: status=%d", ErrUnexpectedStatus, resp.StatusCode)
+		return nil, &statusError{code: resp.StatusCode}
 	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("read response: %w", err)
+		return nil, fmt.Errorf("read response: %w", err)
 	}
 
 	var dingResp struct {
@@ -126,20 +251,67 @@ func (r *Robot) SendWithContext(ctx context.Context, msg Message) (err error) {
 		ErrMsg  string `json:"errmsg"`
 	}
 	if err := json.Unmarshal(body, &dingResp); err != nil {
-		return fmt.Errorf("unmarshal response: %w", err)
+		return nil, fmt.Errorf("unmarshal response: %w", err)
 	}
 	if dingResp.ErrCode != 0 {
-		return fmt.Errorf("%w: errcode=%d, errmsg=%s", ErrUnexpectedResponse, dingResp.ErrCode, dingResp.ErrMsg)
+		return nil, &APIError{ErrCode: dingResp.ErrCode, ErrMsg: dingResp.ErrMsg}
 	}
-	return nil
+	return &SendResponse{ErrCode: dingResp.ErrCode, ErrMsg: dingResp.ErrMsg, Body: body}, nil
+}
+
+// statusError reports a non-200 HTTP status and matches ErrUnexpectedStatus.
+type statusError struct {
+	code int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("%v: status=%d", ErrUnexpectedStatus, e.code)
+}
+
+func (e *statusError) Unwrap() error {
+	return ErrUnexpectedStatus
+}
+
+// APIError is returned when DingTalk answers with a non-zero errcode. It
+// matches ErrUnexpectedResponse and, for well-known codes, ErrRateLimited,
+// ErrInvalidToken, or ErrSecurityRejected, so callers can branch with
+// errors.Is or inspect the code with errors.As.
+type APIError struct {
+	ErrCode int
+	ErrMsg  string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%v: errcode=%d, errmsg=%s", ErrUnexpectedResponse, e.ErrCode, e.ErrMsg)
+}
+
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrRateLimited:
+		return e.ErrCode == rateLimitErrCode
+	case ErrInvalidToken:
+		return e.ErrCode == invalidTokenErrCode
+	case ErrSecurityRejected:
+		return e.ErrCode == securityRejectedErrCode
+	default:
+		return false
+	}
+}
+
+func (e *APIError) Unwrap() error {
+	return ErrUnexpectedResponse
 }
 
 func (r *Robot) calculateSign(timestamp int64) (string, error) {
-	stringToSign := fmt.Sprintf("%d\n%s", timestamp, r.secret)
-	h := hmac.New(sha256.New, []byte(r.secret))
-	h.Write([]byte(stringToSign))
-	sign := base64.StdEncoding.EncodeToString(h.Sum(nil))
-	return sign, nil
+	return base64.StdEncoding.EncodeToString(signatureMAC(timestamp, r.secret)), nil
+}
+
+// signatureMAC returns the HMAC-SHA256 of "timestamp\nsecret" keyed by secret,
+// the scheme DingTalk uses for both robot webhooks and outgoing callbacks.
+func signatureMAC(timestamp int64, secret string) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(h, "%d\n%s", timestamp, secret)
+	return h.Sum(nil)
 }
 
 func defaultTransport() *http.Transport {
@@ -3,12 +3,19 @@ package dingtalk
 import (
 	"bytes"
 	"context"
+	"errors"
 	"io"
+	"net"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewRobot(t *testing.T) {
@@ -28,6 +35,20 @@ func TestNewRobot_DefaultTransportClonesHTTPDefaultTransport(t *testing.T) {
 	assert.Equal(t, 100, transport.MaxIdleConnsPerHost)
 }
 
+func TestNewRobotWithTransport(t *testing.T) {
+	transport := &http.Transport{MaxIdleConnsPerHost: 10}
+	a := NewRobotWithTransport("token_a", transport)
+	b := NewRobotWithTransport("token_b", transport)
+
+	assert.Same(t, transport, a.httpClient.Transport)
+	assert.Same(t, transport, b.httpClient.Transport)
+	assert.Equal(t, sendTimeout, a.httpClient.Timeout)
+	assert.NotNil(t, a.limiter)
+
+	fallback := NewRobotWithTransport("token_c", nil)
+	assert.Same(t, getDefaultClient(), fallback.httpClient)
+}
+
 func TestRobot_WithSecret(t *testing.T) {
 	robot := NewRobot("test_token")
 	result := robot.WithSecret("test_secret")
@@ -133,6 +154,65 @@ func TestMessagePayload(t *testing.T) {
 	assert.NotEmpty(t, payload)
 }
 
+// BenchmarkRobot_Transport compares a transport shared by all robots with a
+// new transport per robot. The conns/op metric shows how many TCP connections
+// each send opened.
+func BenchmarkRobot_Transport(b *testing.B) {
+	var conns atomic.Int64
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, `{"errcode":0,"errmsg":"ok"}`)
+	}))
+	srv.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	srv.Start()
+	defer srv.Close()
+
+	target, _ := url.Parse(srv.URL)
+	redirect := func(transport *http.Transport) http.RoundTripper {
+		return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			req.URL.Scheme = target.Scheme
+			req.URL.Host = target.Host
+			return transport.RoundTrip(req)
+		})
+	}
+
+	run := func(b *testing.B, newTransport func() *http.Transport) {
+		conns.Store(0)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			robot := NewRobotWithTransport("test_token", redirect(newTransport())).WithRateLimit(0)
+			if err := robot.SendWithContext(context.Background(), NewTextMsg("hello")); err != nil {
+				b.Fatal(err)
+			}
+		}
+		b.ReportMetric(float64(conns.Load())/float64(b.N), "conns/op")
+	}
+
+	b.Run("shared", func(b *testing.B) {
+		shared := defaultTransport()
+		defer shared.CloseIdleConnections()
+		run(b, func() *http.Transport { return shared })
+	})
+
+	b.Run("per robot", func(b *testing.B) {
+		var created []*http.Transport
+		defer func() {
+			for _, t := range created {
+				t.CloseIdleConnections()
+			}
+		}()
+		run(b, func() *http.Transport {
+			t := defaultTransport()
+			created = append(created, t)
+			return t
+		})
+	})
+}
+
 type roundTripFunc func(*http.Request) (*http.Response, error)
 
 func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
@@ -146,3 +226,109 @@ func jsonResponse(status int, body string) *http.Response {
 		Body:       io.NopCloser(bytes.NewBufferString(body)),
 	}
 }
+
+func TestAPIError(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    int
+		matches error
+	}{
+		{"rate limited", 130101, ErrRateLimited},
+		{"invalid token", 300001, ErrInvalidToken},
+		{"security rejected", 310000, ErrSecurityRejected},
+	}
+	sentinels := []error{ErrRateLimited, ErrInvalidToken, ErrSecurityRejected}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := error(&APIError{ErrCode: tt.code, ErrMsg: "msg"})
+			assert.ErrorIs(t, err, ErrUnexpectedResponse)
+			for _, sentinel := range sentinels {
+				assert.Equal(t, sentinel == tt.matches, errors.Is(err, sentinel), sentinel)
+			}
+		})
+	}
+
+	err := &APIError{ErrCode: 400101, ErrMsg: "unknown"}
+	assert.Equal(t, "unexpected response: errcode=400101, errmsg=unknown", err.Error())
+	assert.NotErrorIs(t, err, ErrRateLimited)
+}
+
+func TestRobot_SendAndResponse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, `{"errcode":0,"errmsg":"ok","messageId":"msg-42"}`)
+	}))
+	defer srv.Close()
+
+	robot := NewRobot("test_token").WithClient(serverClient(srv))
+	resp, err := robot.SendAndResponse(NewTextMsg("Hello"))
+	require.NoError(t, err)
+	require.NotNil(t, resp)
+	assert.Equal(t, 0, resp.ErrCode)
+	assert.Equal(t, "ok", resp.ErrMsg)
+	assert.JSONEq(t, `{"errcode":0,"errmsg":"ok","messageId":"msg-42"}`, string(resp.Body))
+}
+
+func TestRobot_SendAndResponse_Error(t *testing.T) {
+	robot := NewRobot("test_token").WithClient(&http.Client{
+		Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			return jsonResponse(http.StatusOK, `{"errcode":310000,"errmsg":"keywords not in content"}`), nil
+		}),
+	})
+
+	resp, err := robot.SendAndResponseWithContext(context.Background(), NewTextMsg("Hello"))
+	assert.ErrorIs(t, err, ErrSecurityRejected)
+	assert.Nil(t, resp)
+
+	resp, err = robot.SendAndResponse(nil)
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
+func TestRobot_SendWithContext_ReturnsAPIError(t *testing.T) {
+	robot := NewRobot("test_token").WithClient(&http.Client{
+		Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			return jsonResponse(http.StatusOK, `{"errcode":300001,"errmsg":"token is not exist"}`), nil
+		}),
+	})
+
+	err := robot.SendWithContext(context.Background(), NewTextMsg("Hello"))
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 300001, apiErr.ErrCode)
+	assert.Equal(t, "token is not exist", apiErr.ErrMsg)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestRobot_WithBaseURL(t *testing.T) {
+	var got *url.URL
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.URL
+		_, _ = io.WriteString(w, `{"errcode":0,"errmsg":"ok"}`)
+	}))
+	defer srv.Close()
+
+	robot := NewRobot("test_token").
+		WithSecret("test_secret").
+		WithClient(srv.Client()).
+		WithBaseURL(srv.URL + "/proxy/robot/send")
+	require.NoError(t, robot.Send(NewTextMsg("Hello")))
+
+	require.NotNil(t, got)
+	assert.Equal(t, "/proxy/robot/send", got.Path)
+	query := got.Query()
+	assert.Equal(t, "test_token", query.Get("access_token"))
+	timestamp, err := strconv.ParseInt(query.Get("timestamp"), 10, 64)
+	require.NoError(t, err)
+	assert.True(t, VerifySignature(timestamp, query.Get("sign"), "test_secret"))
+}
+
+func TestRobot_WithBaseURL_DefaultAndInvalid(t *testing.T) {
+	robot := NewRobot("test_token")
+	assert.Equal(t, defaultBaseURL, robot.baseURL)
+	assert.Equal(t, defaultBaseURL, robot.WithBaseURL("").baseURL)
+
+	err := robot.WithBaseURL("http://[::1").Send(NewTextMsg("Hello"))
+	assert.ErrorContains(t, err, "parse base url")
+}
@@ -0,0 +1,126 @@
+package dingtalk
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// maxContentBytes is the largest message body DingTalk accepts.
+	maxContentBytes = 20000
+	// maxFeedLinks is the most links DingTalk renders in one feed card.
+	maxFeedLinks = 12
+)
+
+// ErrInvalidMessage is returned when a message fails validation before sending.
+var ErrInvalidMessage = errors.New("invalid message")
+
+// ValidateMessage checks msg against DingTalk's required fields and size limits
+// before it is sent. Messages that do not implement Validate() error are
+// accepted as is.
+func ValidateMessage(msg Message) error {
+	if msg == nil {
+		return fmt.Errorf("%w: message is nil", ErrInvalidMessage)
+	}
+	if v, ok := msg.(interface{ Validate() error }); ok {
+		return v.Validate()
+	}
+	return nil
+}
+
+// Validate requires non-empty content within the size limit.
+func (m *TextMsg) Validate() error {
+	return checkContent("text content", m.Text.Content)
+}
+
+// Validate requires a title and non-empty text within the size limit.
+func (m *MarkdownMsg) Validate() error {
+	if m.Markdown.Title == "" {
+		return fmt.Errorf("%w: markdown title is empty", ErrInvalidMessage)
+	}
+	return checkContent("markdown text", m.Markdown.Text)
+}
+
+// Validate requires a title, text within the size limit, and a message URL.
+func (m *LinkMsg) Validate() error {
+	if m.Link.Title == "" {
+		return fmt.Errorf("%w: link title is empty", ErrInvalidMessage)
+	}
+	if m.Link.MessageURL == "" {
+		return fmt.Errorf("%w: link message url is empty", ErrInvalidMessage)
+	}
+	return checkContent("link text", m.Link.Text)
+}
+
+// Validate requires a title, text within the size limit, and either a single
+// button (title and URL) or a list of buttons, but not both. A button
+// orientation, if set, must be one of the BtnOrientation constants.
+func (m *ActionCardMsg) Validate() error {
+	card := m.ActionCard
+	if card.Title == "" {
+		return fmt.Errorf("%w: action card title is empty", ErrInvalidMessage)
+	}
+	if err := checkContent("action card text", card.Text); err != nil {
+		return err
+	}
+
+	single := card.SingleTitle != "" || card.SingleURL != ""
+	switch {
+	case single && len(card.Btns) > 0:
+		return fmt.Errorf("%w: action card has both single button and btns", ErrInvalidMessage)
+	case single:
+		if card.SingleTitle == "" || card.SingleURL == "" {
+			return fmt.Errorf("%w: action card single button needs title and url", ErrInvalidMessage)
+		}
+	case len(card.Btns) == 0:
+		return fmt.Errorf("%w: action card has no buttons", ErrInvalidMessage)
+	}
+
+	for i, btn := range card.Btns {
+		if btn.Title == "" || btn.ActionURL == "" {
+			return fmt.Errorf("%w: action card button %d needs title and url", ErrInvalidMessage, i)
+		}
+	}
+
+	switch card.BtnOrientation {
+	case "", BtnOrientationVertical, BtnOrientationHorizontal:
+	default:
+		return fmt.Errorf("%w: action card button orientation %q is unknown", ErrInvalidMessage, card.BtnOrientation)
+	}
+	return nil
+}
+
+// Validate requires 1 to 12 links, each with a title, message URL, and picture URL.
+func (m *FeedCardMsg) Validate() error {
+	links := m.FeedCard.Links
+	if len(links) == 0 {
+		return fmt.Errorf("%w: feed card has no links", ErrInvalidMessage)
+	}
+	if len(links) > maxFeedLinks {
+		return fmt.Errorf("%w: feed card has %d links, limit is %d", ErrInvalidMessage, len(links), maxFeedLinks)
+	}
+	for i, link := range links {
+		if link.Title == "" || link.MessageURL == "" || link.PicURL == "" {
+			return fmt.Errorf("%w: feed card link %d needs title, message url, and pic url", ErrInvalidMessage, i)
+		}
+	}
+	return nil
+}
+
+// Validate requires a picture URL.
+func (m *ImageMsg) Validate() error {
+	if m.Image.PicURL == "" {
+		return fmt.Errorf("%w: image picture url is empty", ErrInvalidMessage)
+	}
+	return nil
+}
+
+func checkContent(field, s string) error {
+	if s == "" {
+		return fmt.Errorf("%w: %s is empty", ErrInvalidMessage, field)
+	}
+	if len(s) > maxContentBytes {
+		return fmt.Errorf("%w: %s is %d bytes, limit is %d", ErrInvalidMessage, field, len(s), maxContentBytes)
+	}
+	return nil
+}
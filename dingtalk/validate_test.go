@@ -0,0 +1,101 @@
+package dingtalk
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMessage(t *testing.T) {
+	tests := []struct {
+		name    string
+		msg     Message
+		wantErr string
+	}{
+		{name: "text", msg: NewTextMsg("hello")},
+		{name: "text at limit", msg: NewTextMsg(strings.Repeat("a", maxContentBytes))},
+		{name: "text over limit", msg: NewTextMsg(strings.Repeat("a", maxContentBytes+1)), wantErr: "text content is 20001 bytes"},
+		{name: "empty text", msg: NewTextMsg(""), wantErr: "text content is empty"},
+		{name: "markdown", msg: NewMarkdownMsg("title", "# body")},
+		{name: "markdown without title", msg: NewMarkdownMsg("", "# body"), wantErr: "markdown title is empty"},
+		{name: "markdown over limit", msg: NewMarkdownMsg("title", strings.Repeat("界", 7000)), wantErr: "markdown text is 21000 bytes"},
+		{name: "link", msg: NewLinkMsg("title", "text", "https://example.com")},
+		{name: "link without url", msg: NewLinkMsg("title", "text", ""), wantErr: "link message url is empty"},
+		{name: "single action card", msg: NewSingleActionCard("title", "text", "Read", "https://example.com")},
+		{name: "single action card without url", msg: NewSingleActionCard("title", "text", "Read", ""), wantErr: "single button needs title and url"},
+		{
+			name: "multi action card",
+			msg:  NewMultiActionCard("title", "text", []ActionCardBtn{{Title: "Yes", ActionURL: "https://example.com/y"}}),
+		},
+		{
+			name:    "action card without buttons",
+			msg:     NewMultiActionCard("title", "text", nil),
+			wantErr: "action card has no buttons",
+		},
+		{
+			name: "action card with single and btns",
+			msg: func() Message {
+				m := NewSingleActionCard("title", "text", "Read", "https://example.com")
+				m.ActionCard.Btns = []ActionCardBtn{{Title: "Yes", ActionURL: "https://example.com/y"}}
+				return m
+			}(),
+			wantErr: "both single button and btns",
+		},
+		{
+			name:    "single action card without button",
+			msg:     NewSingleActionCard("title", "text", "", ""),
+			wantErr: "action card has no buttons",
+		},
+		{
+			name: "action card with unknown orientation",
+			msg: func() Message {
+				m := NewMultiActionCard("title", "text", []ActionCardBtn{{Title: "Yes", ActionURL: "https://example.com/y"}})
+				m.ActionCard.BtnOrientation = "2"
+				return m
+			}(),
+			wantErr: `orientation "2" is unknown`,
+		},
+		{
+			name:    "action card button without url",
+			msg:     NewMultiActionCard("title", "text", []ActionCardBtn{{Title: "Yes"}}),
+			wantErr: "button 0 needs title and url",
+		},
+		{name: "feed card", msg: NewFeedCardMsg([]FeedLink{{Title: "a", MessageURL: "u", PicURL: "p"}})},
+		{name: "empty feed card", msg: NewFeedCardMsg(nil), wantErr: "feed card has no links"},
+		{name: "image", msg: NewImageMsg("https://example.com/a.png")},
+		{name: "image without url", msg: NewImageMsg(""), wantErr: "image picture url is empty"},
+		{name: "nil", msg: nil, wantErr: "message is nil"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMessage(tt.msg)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, ErrInvalidMessage)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestRobot_SendWithContext_ValidatesBeforeSending(t *testing.T) {
+	called := false
+	robot := NewRobot("test_token").WithClient(&http.Client{
+		Transport: roundTripFunc(func(*http.Request) (*http.Response, error) {
+			called = true
+			return jsonResponse(http.StatusOK, `{"errcode":0,"errmsg":"ok"}`), nil
+		}),
+	})
+
+	err := robot.SendWithContext(context.Background(), NewTextMsg(strings.Repeat("a", maxContentBytes+1)))
+	assert.ErrorIs(t, err, ErrInvalidMessage)
+
+	err = robot.SendAsync(NewMultiActionCard("title", "text", nil))
+	assert.ErrorIs(t, err, ErrInvalidMessage)
+	assert.False(t, called)
+}
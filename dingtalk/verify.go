@@ -0,0 +1,45 @@
+package dingtalk
+
+import (
+	"crypto/hmac"
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// maxSignatureAge is how far a callback timestamp may be from the current
+// time. DingTalk documents requests older than one hour as invalid.
+const maxSignatureAge = time.Hour
+
+// VerifySignature reports whether sign is the base64 HMAC-SHA256 signature
+// DingTalk computes for timestamp (Unix milliseconds) with secret. The
+// comparison runs in constant time. It does not check how old timestamp is;
+// VerifyRequest does.
+func VerifySignature(timestamp int64, sign, secret string) bool {
+	if sign == "" || secret == "" {
+		return false
+	}
+	got, err := base64.StdEncoding.DecodeString(sign)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(got, signatureMAC(timestamp, secret))
+}
+
+// VerifyRequest reports whether r is a genuine DingTalk outgoing callback: its
+// "timestamp" and "sign" headers must carry a valid signature for secret, and
+// the timestamp must be within one hour of the current time.
+func VerifyRequest(r *http.Request, secret string) bool {
+	if r == nil {
+		return false
+	}
+	timestamp, err := strconv.ParseInt(r.Header.Get("timestamp"), 10, 64)
+	if err != nil {
+		return false
+	}
+	if age := time.Since(time.UnixMilli(timestamp)); age > maxSignatureAge || age < -maxSignatureAge {
+		return false
+	}
+	return VerifySignature(timestamp, r.Header.Get("sign"), secret)
+}
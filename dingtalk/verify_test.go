@@ -0,0 +1,52 @@
+package dingtalk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySignature(t *testing.T) {
+	const timestamp = 1234567890000
+	robot := NewRobot("test_token").WithSecret("test_secret")
+	sign, err := robot.calculateSign(timestamp)
+	require.NoError(t, err)
+
+	assert.True(t, VerifySignature(timestamp, sign, "test_secret"))
+	assert.False(t, VerifySignature(timestamp+1, sign, "test_secret"))
+	assert.False(t, VerifySignature(timestamp, sign, "other_secret"))
+	assert.False(t, VerifySignature(timestamp, sign[:len(sign)-4], "test_secret"))
+	assert.False(t, VerifySignature(timestamp, "not base64!", "test_secret"))
+	assert.False(t, VerifySignature(timestamp, "", "test_secret"))
+	assert.False(t, VerifySignature(timestamp, sign, ""))
+}
+
+func TestVerifyRequest(t *testing.T) {
+	robot := NewRobot("test_token").WithSecret("test_secret")
+	newRequest := func(timestamp int64, sign string) *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/callback", nil)
+		req.Header.Set("timestamp", strconv.FormatInt(timestamp, 10))
+		req.Header.Set("sign", sign)
+		return req
+	}
+
+	now := time.Now().UnixMilli()
+	sign, err := robot.calculateSign(now)
+	require.NoError(t, err)
+	assert.True(t, VerifyRequest(newRequest(now, sign), "test_secret"))
+	assert.False(t, VerifyRequest(newRequest(now, sign), "other_secret"))
+
+	stale := time.Now().Add(-2 * time.Hour).UnixMilli()
+	staleSign, err := robot.calculateSign(stale)
+	require.NoError(t, err)
+	assert.False(t, VerifyRequest(newRequest(stale, staleSign), "test_secret"))
+
+	missing := httptest.NewRequest(http.MethodPost, "/callback", nil)
+	assert.False(t, VerifyRequest(missing, "test_secret"))
+	assert.False(t, VerifyRequest(nil, "test_secret"))
+}
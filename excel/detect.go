@@ -0,0 +1,61 @@
+package excel
+
+import (
+	"bytes"
+	"errors"
+	"io"
+)
+
+// Format identifies the container format of a workbook.
+type Format int
+
+const (
+	// FormatUnknown is neither an Open XML nor a legacy workbook.
+	FormatUnknown Format = iota
+	// FormatOpenXML is a ZIP-based workbook such as .xlsx or .xlsm.
+	FormatOpenXML
+	// FormatLegacy is a binary OLE2 workbook such as .xls.
+	FormatLegacy
+)
+
+var (
+	zipMagic = []byte("PK\x03\x04")
+	oleMagic = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+)
+
+func (f Format) String() string {
+	switch f {
+	case FormatOpenXML:
+		return "openxml"
+	case FormatLegacy:
+		return "legacy"
+	default:
+		return "unknown"
+	}
+}
+
+// DetectExcel sniffs the leading bytes of r because file extensions are
+// unreliable. Only FormatOpenXML can be read by this package. Any ZIP archive
+// is reported as FormatOpenXML and any OLE2 file as FormatLegacy, so opening
+// the workbook remains the final check.
+func DetectExcel(r io.ReaderAt) (Format, error) {
+	if r == nil {
+		return FormatUnknown, errNilReader
+	}
+
+	buf := make([]byte, len(oleMagic))
+	n, err := r.ReadAt(buf, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return FormatUnknown, err
+	}
+	buf = buf[:n]
+
+	switch {
+	case bytes.HasPrefix(buf, zipMagic):
+		return FormatOpenXML, nil
+	case bytes.HasPrefix(buf, oleMagic):
+		return FormatLegacy, nil
+	default:
+		return FormatUnknown, nil
+	}
+}
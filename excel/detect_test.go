@@ -0,0 +1,52 @@
+package excel
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingReaderAt struct{}
+
+func (failingReaderAt) ReadAt([]byte, int64) (int, error) {
+	return 0, errors.New("disk error")
+}
+
+func TestDetectExcel(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want Format
+	}{
+		{"xlsx workbook", workbookBytes(t, [][]any{{"a"}}), FormatOpenXML},
+		{"legacy xls", append([]byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}, make([]byte, 504)...), FormatLegacy},
+		{"csv", []byte("id,name\n1,Alice\n"), FormatUnknown},
+		{"short", []byte("PK"), FormatUnknown},
+		{"empty", nil, FormatUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DetectExcel(bytes.NewReader(tt.data))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDetectExcel_Errors(t *testing.T) {
+	_, err := DetectExcel(nil)
+	assert.ErrorIs(t, err, errNilReader)
+
+	_, err = DetectExcel(failingReaderAt{})
+	assert.ErrorContains(t, err, "disk error")
+}
+
+func TestFormat_String(t *testing.T) {
+	assert.Equal(t, "openxml", FormatOpenXML.String())
+	assert.Equal(t, "legacy", FormatLegacy.String())
+	assert.Equal(t, "unknown", FormatUnknown.String())
+}
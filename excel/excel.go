@@ -3,35 +3,63 @@ package excel
 import (
 	"errors"
 	"fmt"
+	"io"
 	"path/filepath"
 	"reflect"
 	"slices"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/xuri/excelize/v2"
 )
 
-const tagKey = "excel"
+const (
+	tagKey = "excel"
+
+	// defaultTimeLayout parses time.Time fields that do not specify a layout.
+	defaultTimeLayout = time.DateOnly
+)
+
+var timeType = reflect.TypeOf(time.Time{})
 
 var (
 	structCache sync.Map
 
 	errNilCallback   = errors.New("excel: callback is nil")
+	errNilReader     = errors.New("excel: reader is nil")
+	errNilWriter     = errors.New("excel: writer is nil")
 	errInvalidTarget = errors.New("excel: invalid target")
 	errInvalidColumn = errors.New("excel: invalid column")
 	errUnexported    = errors.New("excel: field is unexported")
 	errUnsupported   = errors.New("excel: unsupported type")
 	errValueOverflow = errors.New("excel: value overflows target type")
 	errEmptyColumn   = errors.New("excel: column name is empty")
+
+	// errStopWalk ends a streamed walk early without reporting an error.
+	errStopWalk = errors.New("excel: stop walk")
 )
 
 func IsXLSX(filename string) bool {
 	return strings.EqualFold(filepath.Ext(filename), ".xlsx")
 }
 
-func Read(path string) (data map[string][][]string, err error) {
+// IsExcel reports whether filename has an Excel workbook extension: .xlsx,
+// macro-enabled .xlsm, or legacy .xls. Use IsLegacyExcel to reject .xls files,
+// which this package cannot read.
+func IsExcel(filename string) bool {
+	ext := filepath.Ext(filename)
+	return strings.EqualFold(ext, ".xlsx") || strings.EqualFold(ext, ".xlsm") || IsLegacyExcel(filename)
+}
+
+// IsLegacyExcel reports whether filename has the legacy binary .xls extension.
+func IsLegacyExcel(filename string) bool {
+	return strings.EqualFold(filepath.Ext(filename), ".xls")
+}
+
+// Read returns every sheet of the workbook at path keyed by sheet name.
+func Read(path string, opts ...Option) (data map[string][][]string, err error) {
 	wb, err := Open(path)
 	if err != nil {
 		return nil, err
@@ -41,10 +69,11 @@ func Read(path string) (data map[string][][]string, err error) {
 			err = fmt.Errorf("close workbook: %w", closeErr)
 		}
 	}()
-	return wb.ReadAll()
+	return wb.ReadAll(opts...)
 }
 
-func ReadSheet(path, name string) (rows [][]string, err error) {
+// ReadSheet returns the rows of one sheet of the workbook at path.
+func ReadSheet(path, name string, opts ...Option) (rows [][]string, err error) {
 	wb, err := Open(path)
 	if err != nil {
 		return nil, err
@@ -54,7 +83,7 @@ func ReadSheet(path, name string) (rows [][]string, err error) {
 			err = fmt.Errorf("close workbook: %w", closeErr)
 		}
 	}()
-	return wb.Sheet(name).Rows()
+	return wb.Sheet(name).Rows(opts...)
 }
 
 // Walk opens path and calls fn for each row in one sheet. The index passed to
@@ -76,6 +105,31 @@ func Walk(path, name string, fn func(int, []string) error) (err error) {
 	return wb.Sheet(name).Scan(fn)
 }
 
+// WalkReader reads a workbook from r and calls fn for each row in one sheet
+// without loading the whole sheet into memory. The index passed to fn is the
+// 1-based sheet row number, also when opts skip rows. Options filter rows as
+// they stream past, as they do for ReadSheet. Walking stops at the first error
+// returned by fn.
+func WalkReader(r io.Reader, name string, fn func(int, []string) error, opts ...Option) (err error) {
+	if fn == nil {
+		return errNilCallback
+	}
+
+	wb, err := OpenReader(r)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := wb.Close(); err == nil && closeErr != nil {
+			err = fmt.Errorf("close workbook: %w", closeErr)
+		}
+	}()
+	if err := wb.Sheet(name).Scan(newConfig(opts...).walk(fn)); !errors.Is(err, errStopWalk) {
+		return err
+	}
+	return nil
+}
+
 // ScanRow parses each row into T and calls fn for rows that parse successfully.
 // The index passed to fn is 1-based. Rows that fail to parse are skipped.
 func ScanRow[T any](path, name string, fn func(int, *T) error) (err error) {
@@ -139,7 +193,7 @@ func Parse[T any](row []string) (*T, error) {
 		if colIdx >= len(row) {
 			continue
 		}
-		if err := setField(v.Field(f.index), row[colIdx]); err != nil {
+		if err := setField(v.Field(f.index), row[colIdx], defaultTimeLayout); err != nil {
 			return nil, fmt.Errorf("column %s: %w", columnName(colIdx), err)
 		}
 	}
@@ -168,6 +222,19 @@ func Open(path string) (*Workbook, error) {
 	return &Workbook{path: path, file: f}, nil
 }
 
+// OpenReader reads an Excel workbook from r. Call Close when the workbook is no
+// longer needed.
+func OpenReader(r io.Reader) (*Workbook, error) {
+	if r == nil {
+		return nil, errNilReader
+	}
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return &Workbook{file: f}, nil
+}
+
 func (w *Workbook) Close() error {
 	if w.file != nil {
 		return w.file.Close()
@@ -186,12 +253,12 @@ func (w *Workbook) Sheet(name string) *Sheet {
 	return &Sheet{file: w.file, name: name}
 }
 
-func (w *Workbook) ReadAll() (map[string][][]string, error) {
+func (w *Workbook) ReadAll(opts ...Option) (map[string][][]string, error) {
 	sheets := w.Sheets()
 	result := make(map[string][][]string, len(sheets))
 
 	for _, sheet := range sheets {
-		rows, err := w.Sheet(sheet).Rows()
+		rows, err := w.Sheet(sheet).Rows(opts...)
 		if err != nil {
 			return nil, err
 		}
@@ -206,8 +273,14 @@ type Sheet struct {
 	name string
 }
 
-func (s *Sheet) Rows() ([][]string, error) {
-	return s.file.GetRows(s.name)
+// Rows returns every row of the sheet. Empty rows inside the used range are
+// kept as empty slices unless WithSkipEmptyRows is given.
+func (s *Sheet) Rows(opts ...Option) ([][]string, error) {
+	rows, err := s.file.GetRows(s.name)
+	if err != nil {
+		return nil, err
+	}
+	return newConfig(opts...).apply(rows), nil
 }
 
 // Scan streams sheet rows and stops when fn returns an error. The index
@@ -374,7 +447,7 @@ func columnName(n int) string {
 	return s
 }
 
-func setField(v reflect.Value, s string) error {
+func setField(v reflect.Value, s, layout string) error {
 	if !v.CanSet() {
 		return nil
 	}
@@ -387,7 +460,7 @@ func setField(v reflect.Value, s string) error {
 
 		ptr := reflect.New(v.Type().Elem())
 
-		if err := parseValue(ptr.Elem(), s); err != nil {
+		if err := parseValue(ptr.Elem(), s, layout); err != nil {
 			return err
 		}
 
@@ -395,15 +468,24 @@ func setField(v reflect.Value, s string) error {
 		return nil
 	}
 
-	return parseValue(v, s)
+	return parseValue(v, s, layout)
 }
 
-func parseValue(v reflect.Value, s string) error {
+func parseValue(v reflect.Value, s, layout string) error {
 	if s == "" {
 		v.Set(reflect.Zero(v.Type()))
 		return nil
 	}
 
+	if v.Type() == timeType {
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			return fmt.Errorf("parse time %q: %w", s, err)
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	}
+
 	switch v.Kind() {
 	case reflect.String:
 		v.SetString(s)
@@ -1,8 +1,13 @@
 package excel
 
 import (
+	"bytes"
+	"errors"
 	"path/filepath"
+	"runtime"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -32,6 +37,32 @@ func TestIsXLSX(t *testing.T) {
 	}
 }
 
+func TestIsExcel(t *testing.T) {
+	tests := []struct {
+		filename string
+		excel    bool
+		legacy   bool
+	}{
+		{"report.xlsx", true, false},
+		{"report.xlsm", true, false},
+		{"report.xls", true, true},
+		{"REPORT.XLSX", true, false},
+		{"Report.XlsM", true, false},
+		{"report.XLS", true, true},
+		{"dir/report.xls.csv", false, false},
+		{"report.xlsb", false, false},
+		{"xlsx", false, false},
+		{"", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filename, func(t *testing.T) {
+			assert.Equal(t, tt.excel, IsExcel(tt.filename))
+			assert.Equal(t, tt.legacy, IsLegacyExcel(tt.filename))
+		})
+	}
+}
+
 func TestParse(t *testing.T) {
 	t.Run("valid row", func(t *testing.T) {
 		row := []string{"Alice", "25"}
@@ -69,6 +100,19 @@ func TestParse(t *testing.T) {
 		assert.Nil(t, result.Age)
 	})
 
+	t.Run("time field", func(t *testing.T) {
+		type record struct {
+			Day time.Time `excel:"A"`
+		}
+
+		result, err := Parse[record]([]string{"2024-03-05"})
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), result.Day)
+
+		_, err = Parse[record]([]string{"03/05/2024"})
+		assert.Error(t, err)
+	})
+
 	t.Run("non struct type", func(t *testing.T) {
 		_, err := Parse[int]([]string{"1"})
 		assert.ErrorIs(t, err, errInvalidTarget)
@@ -274,3 +318,142 @@ func createTestExcelFile(t testing.TB) string {
 
 	return tmpFile
 }
+
+func TestWalkReader(t *testing.T) {
+	f := excelize.NewFile()
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+
+	const rows = 5000
+	sw, err := f.NewStreamWriter("Sheet1")
+	require.NoError(t, err)
+	for i := 1; i <= rows; i++ {
+		cell, err := excelize.CoordinatesToCellName(1, i)
+		require.NoError(t, err)
+		require.NoError(t, sw.SetRow(cell, []any{"name", i}))
+	}
+	require.NoError(t, sw.Flush())
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Write(&buf))
+	data := buf.Bytes()
+
+	t.Run("visits every row", func(t *testing.T) {
+		var count, last int
+		err := WalkReader(bytes.NewReader(data), "Sheet1", func(idx int, row []string) error {
+			count++
+			last = idx
+			assert.Equal(t, strconv.Itoa(idx), row[1])
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, rows, count)
+		assert.Equal(t, rows, last)
+	})
+
+	t.Run("stops on callback error", func(t *testing.T) {
+		errStop := errors.New("stop")
+		var count int
+		err := WalkReader(bytes.NewReader(data), "Sheet1", func(idx int, _ []string) error {
+			count++
+			if idx == 10 {
+				return errStop
+			}
+			return nil
+		})
+		assert.ErrorIs(t, err, errStop)
+		assert.Equal(t, 10, count)
+	})
+}
+
+func TestWalkReader_BoundedMemory(t *testing.T) {
+	if testing.Short() {
+		t.Skip("generates a large sheet")
+	}
+
+	const rows = 20000
+	rowData := make([][]any, rows)
+	for i := range rowData {
+		rowData[i] = []any{"name", i + 1, "some longer cell text to make rows heavier"}
+	}
+	data := workbookBytes(t, rowData)
+
+	// ReadSheet-style loading keeps every row alive at once.
+	wb, err := OpenReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	before := liveHeap()
+	all, err := wb.Sheet("Sheet1").Rows()
+	require.NoError(t, err)
+	loaded := liveHeap() - before
+	require.Len(t, all, rows)
+	runtime.KeepAlive(all)
+	all = nil
+	require.NoError(t, wb.Close())
+
+	// Streaming keeps the live heap flat as the walk advances.
+	var early, late uint64
+	err = WalkReader(bytes.NewReader(data), "Sheet1", func(idx int, _ []string) error {
+		switch idx {
+		case rows / 10:
+			early = liveHeap()
+		case rows:
+			late = liveHeap()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	var grown uint64
+	if late > early {
+		grown = late - early
+	}
+	assert.Less(t, grown, loaded/4, "walk grew by %d bytes, loading all rows took %d", grown, loaded)
+}
+
+// liveHeap returns the bytes of reachable heap objects after a full collection.
+func liveHeap() uint64 {
+	runtime.GC()
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return m.HeapAlloc
+}
+
+func TestWalkReader_Options(t *testing.T) {
+	data := workbookBytes(t, [][]any{
+		{"Report"},
+		{},
+		{"id", "name"},
+		{"1", " Alice "},
+		{"  ", ""},
+		{"2", "Bob"},
+		{"3", "Carol"},
+	})
+
+	type visit struct {
+		idx int
+		row []string
+	}
+	walk := func(opts ...Option) []visit {
+		var got []visit
+		err := WalkReader(bytes.NewReader(data), "Sheet1", func(idx int, row []string) error {
+			got = append(got, visit{idx, row})
+			return nil
+		}, opts...)
+		require.NoError(t, err)
+		return got
+	}
+
+	assert.Len(t, walk(), 7)
+	assert.Equal(t, []visit{
+		{3, []string{"id", "name"}},
+		{4, []string{"1", "Alice"}},
+		{6, []string{"2", "Bob"}},
+	}, walk(WithStartRow(3), WithTrimCells(true), WithSkipEmptyRows(), WithMaxRows(3)))
+}
+
+func TestWalkReader_Errors(t *testing.T) {
+	assert.ErrorIs(t, WalkReader(bytes.NewReader(nil), "Sheet1", nil), errNilCallback)
+	assert.ErrorIs(t, WalkReader(nil, "Sheet1", func(int, []string) error { return nil }), errNilReader)
+	assert.Error(t, WalkReader(bytes.NewReader([]byte("not a workbook")), "Sheet1", func(int, []string) error { return nil }))
+}
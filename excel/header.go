@@ -0,0 +1,238 @@
+package excel
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// headerTagKey maps struct fields to columns by header text instead of by
+// column letter. Its value is the header name optionally followed by
+// ",required" and ",layout=<time layout>" options; layout must come last.
+const headerTagKey = "header"
+
+var (
+	headerCache sync.Map
+
+	errMissingHeader = errors.New("excel: missing header")
+)
+
+type headerField struct {
+	index    int
+	name     string
+	required bool
+	layout   string
+}
+
+// ReadRecords reads a workbook from r and maps every row after the header
+// into T. The header is the first non-empty row; fields tagged
+// `header:"Name"` receive the cell under the column whose trimmed header
+// equals Name. Unmapped columns are ignored, missing optional headers leave
+// fields at their zero value, and empty rows are skipped. A missing
+// `header:"Name,required"` column returns an error before any row is parsed,
+// as does a sheet without rows when T has a required field. time.Time fields
+// use `layout=` or time.DateOnly.
+//
+// opts filter rows as for ReadSheet before the header is located, so
+// WithStartRow can point at a header below banner rows. WithMaxRows counts
+// records, not the header.
+func ReadRecords[T any](r io.Reader, name string, opts ...Option) (result []T, err error) {
+	fields, err := getHeaderFields[T]()
+	if err != nil {
+		return nil, err
+	}
+
+	result = []T{}
+	columns, err := scanHeaderRows(r, name, fields, opts, func(idx int, columns []int, row []string) error {
+		var v T
+		rv := reflect.ValueOf(&v).Elem()
+		for i, f := range fields {
+			col := columns[i]
+			if col < 0 || col >= len(row) {
+				continue
+			}
+			if err := setField(rv.Field(f.index), row[col], f.layout); err != nil {
+				return fmt.Errorf("row %d: column %q: %w", idx, f.name, err)
+			}
+		}
+		result = append(result, v)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if columns == nil {
+		for _, f := range fields {
+			if f.required {
+				return nil, fmt.Errorf("%w: %q", errMissingHeader, f.name)
+			}
+		}
+	}
+	return result, nil
+}
+
+// ReadColumns reads a workbook from r and returns every row after the header
+// as a map from each requested header to its cell. Header cells are matched
+// after trimming white space, so columns may appear in any order; other
+// columns are ignored, short rows yield empty strings, and empty rows are
+// skipped. A requested header that is absent returns an error. opts behave as
+// for ReadRecords.
+func ReadColumns(r io.Reader, name string, headers []string, opts ...Option) (result []map[string]string, err error) {
+	fields := make([]headerField, len(headers))
+	for i, h := range headers {
+		fields[i] = headerField{name: h, required: true}
+	}
+
+	result = []map[string]string{}
+	columns, err := scanHeaderRows(r, name, fields, opts, func(_ int, columns []int, row []string) error {
+		m := make(map[string]string, len(headers))
+		for i, h := range headers {
+			if col := columns[i]; col < len(row) {
+				m[h] = row[col]
+			} else {
+				m[h] = ""
+			}
+		}
+		result = append(result, m)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if columns == nil && len(headers) > 0 {
+		return nil, fmt.Errorf("%w: %q", errMissingHeader, headers[0])
+	}
+	return result, nil
+}
+
+// scanHeaderRows streams the non-empty rows of one sheet, resolves fields
+// against the first of them, and calls fn for every later row. It returns the
+// resolved columns, or nil when the sheet had no non-empty row.
+func scanHeaderRows(
+	r io.Reader,
+	name string,
+	fields []headerField,
+	opts []Option,
+	fn func(idx int, columns []int, row []string) error,
+) (columns []int, err error) {
+	cfg := newConfig(opts...)
+	cfg.skipEmptyRows = true
+	if cfg.maxRows > 0 {
+		// The header row passes through walk too but is not a record.
+		cfg.maxRows++
+	}
+
+	wb, err := OpenReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := wb.Close(); err == nil && closeErr != nil {
+			err = fmt.Errorf("close workbook: %w", closeErr)
+		}
+	}()
+
+	err = wb.Sheet(name).Scan(cfg.walk(func(idx int, row []string) error {
+		if columns == nil {
+			cols, err := resolveHeaderColumns(fields, row)
+			columns = cols
+			return err
+		}
+		return fn(idx, columns, row)
+	}))
+	if err != nil && !errors.Is(err, errStopWalk) {
+		return nil, err
+	}
+	return columns, nil
+}
+
+func resolveHeaderColumns(fields []headerField, header []string) ([]int, error) {
+	positions := make(map[string]int, len(header))
+	for i, h := range header {
+		h = strings.TrimSpace(h)
+		if _, ok := positions[h]; !ok {
+			positions[h] = i
+		}
+	}
+
+	columns := make([]int, len(fields))
+	for i, f := range fields {
+		col, ok := positions[f.name]
+		if !ok {
+			if f.required {
+				return nil, fmt.Errorf("%w: %q", errMissingHeader, f.name)
+			}
+			col = -1
+		}
+		columns[i] = col
+	}
+	return columns, nil
+}
+
+func getHeaderFields[T any]() ([]headerField, error) {
+	return headerFieldsOf(reflect.TypeFor[T]())
+}
+
+// headerFieldsOf returns the `header`-tagged fields of typ in declaration
+// order.
+func headerFieldsOf(typ reflect.Type) ([]headerField, error) {
+	if typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: got %v", errInvalidTarget, typ)
+	}
+
+	if fields, ok := headerCache.Load(typ); ok {
+		return fields.([]headerField), nil
+	}
+
+	var fields []headerField
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		tag := field.Tag.Get(headerTagKey)
+		if tag == "" || tag == "-" {
+			continue
+		}
+		if field.PkgPath != "" {
+			return nil, fmt.Errorf("field %s: %w", field.Name, errUnexported)
+		}
+
+		f, err := parseHeaderTag(tag)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		f.index = i
+		fields = append(fields, f)
+	}
+
+	headerCache.Store(typ, fields)
+	return fields, nil
+}
+
+func parseHeaderTag(tag string) (headerField, error) {
+	f := headerField{layout: defaultTimeLayout}
+
+	name, opts, _ := strings.Cut(tag, ",")
+	f.name = strings.TrimSpace(name)
+	if f.name == "" {
+		return headerField{}, errEmptyColumn
+	}
+
+	for opts != "" {
+		if layout, ok := strings.CutPrefix(opts, "layout="); ok {
+			f.layout = layout
+			break
+		}
+		var opt string
+		opt, opts, _ = strings.Cut(opts, ",")
+		switch strings.TrimSpace(opt) {
+		case "required":
+			f.required = true
+		default:
+			return headerField{}, fmt.Errorf("excel: unknown header option %q", opt)
+		}
+	}
+	return f, nil
+}
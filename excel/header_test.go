@@ -0,0 +1,211 @@
+package excel
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+type employee struct {
+	Name   string    `header:"Name,required"`
+	Age    int       `header:"Age"`
+	Salary float64   `header:"Salary"`
+	Active bool      `header:"Active"`
+	Joined time.Time `header:"Joined,layout=Jan 2, 2006"`
+	Note   *string   `header:"Note"`
+	Ignore string
+}
+
+func TestReadRecords(t *testing.T) {
+	data := workbookBytes(t, [][]any{
+		{"Active", "Unused", " Name ", "Joined", "Age", "Salary"},
+		{"true", "x", "Alice", "Mar 5, 2024", "30", "1234.5"},
+		{"false", "y", "Bob", "", "", "0"},
+	})
+
+	records, err := ReadRecords[employee](bytes.NewReader(data), "Sheet1")
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	assert.Equal(t, "Alice", records[0].Name)
+	assert.Equal(t, 30, records[0].Age)
+	assert.InDelta(t, 1234.5, records[0].Salary, 0.0001)
+	assert.True(t, records[0].Active)
+	assert.Equal(t, time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), records[0].Joined)
+	assert.Nil(t, records[0].Note)
+
+	assert.Equal(t, "Bob", records[1].Name)
+	assert.Zero(t, records[1].Age)
+	assert.True(t, records[1].Joined.IsZero())
+}
+
+func TestReadRecords_HeaderOnly(t *testing.T) {
+	data := workbookBytes(t, [][]any{{"Name"}})
+
+	records, err := ReadRecords[employee](bytes.NewReader(data), "Sheet1")
+	require.NoError(t, err)
+	assert.Empty(t, records)
+}
+
+func TestReadRecords_EmptySheet(t *testing.T) {
+	data := workbookBytes(t, nil)
+
+	_, err := ReadRecords[employee](bytes.NewReader(data), "Sheet1")
+	assert.ErrorIs(t, err, errMissingHeader)
+	assert.ErrorContains(t, err, `"Name"`)
+
+	type optional struct {
+		Age int `header:"Age"`
+	}
+	records, err := ReadRecords[optional](bytes.NewReader(data), "Sheet1")
+	require.NoError(t, err)
+	assert.Empty(t, records)
+}
+
+func TestReadRecords_SkipsEmptyRows(t *testing.T) {
+	data := workbookBytes(t, [][]any{{"Name"}, {"a"}, {}, {"  "}, {"b"}})
+
+	records, err := ReadRecords[employee](bytes.NewReader(data), "Sheet1")
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, "a", records[0].Name)
+	assert.Equal(t, "  ", records[1].Name)
+	assert.Equal(t, "b", records[2].Name)
+
+	records, err = ReadRecords[employee](bytes.NewReader(data), "Sheet1", WithTrimCells(true))
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "b", records[1].Name)
+}
+
+func TestReadRecords_Options(t *testing.T) {
+	data := workbookBytes(t, [][]any{
+		{"Quarterly report"},
+		{},
+		{"Name", "Age"},
+		{"Alice", "30"},
+		{"Bob", "40"},
+		{"Carol", "50"},
+	})
+
+	records, err := ReadRecords[employee](bytes.NewReader(data), "Sheet1", WithStartRow(3), WithMaxRows(2))
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "Alice", records[0].Name)
+	assert.Equal(t, "Bob", records[1].Name)
+
+	_, err = ReadRecords[employee](bytes.NewReader(data), "Sheet1")
+	assert.ErrorIs(t, err, errMissingHeader, "banner row is taken as the header without WithStartRow")
+
+	rows, err := ReadColumns(bytes.NewReader(data), "Sheet1", []string{"Age"}, WithStartRow(3), WithMaxRows(1))
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]string{{"Age": "30"}}, rows)
+}
+
+func TestReadRecords_MissingRequiredHeader(t *testing.T) {
+	data := workbookBytes(t, [][]any{
+		{"Full Name", "Age"},
+		{"Alice", "30"},
+	})
+
+	_, err := ReadRecords[employee](bytes.NewReader(data), "Sheet1")
+	assert.ErrorIs(t, err, errMissingHeader)
+	assert.ErrorContains(t, err, `"Name"`)
+}
+
+func TestReadRecords_ConversionError(t *testing.T) {
+	data := workbookBytes(t, [][]any{
+		{"Name", "Age"},
+		{"Alice", "30"},
+		{"Bob", "thirty"},
+	})
+
+	_, err := ReadRecords[employee](bytes.NewReader(data), "Sheet1")
+	require.Error(t, err)
+	assert.ErrorContains(t, err, `row 3: column "Age"`)
+}
+
+func TestReadRecords_InvalidTarget(t *testing.T) {
+	_, err := ReadRecords[int](bytes.NewReader(nil), "Sheet1")
+	assert.ErrorIs(t, err, errInvalidTarget)
+
+	type unknownOption struct {
+		Name string `header:"Name,unique"`
+	}
+	_, err = ReadRecords[unknownOption](bytes.NewReader(nil), "Sheet1")
+	assert.ErrorContains(t, err, "unknown header option")
+}
+
+func TestParseHeaderTag(t *testing.T) {
+	f, err := parseHeaderTag("Joined,required,layout=2006-01-02, 15:04")
+	require.NoError(t, err)
+	assert.Equal(t, "Joined", f.name)
+	assert.True(t, f.required)
+	assert.Equal(t, "2006-01-02, 15:04", f.layout)
+
+	f, err = parseHeaderTag("Name")
+	require.NoError(t, err)
+	assert.False(t, f.required)
+	assert.Equal(t, defaultTimeLayout, f.layout)
+
+	_, err = parseHeaderTag(",required")
+	assert.ErrorIs(t, err, errEmptyColumn)
+}
+
+func workbookBytes(t testing.TB, rows [][]any) []byte {
+	f := excelize.NewFile()
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+
+	for i, row := range rows {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		require.NoError(t, err)
+		require.NoError(t, f.SetSheetRow("Sheet1", cell, &row))
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, f.Write(&buf))
+	return buf.Bytes()
+}
+
+func TestReadColumns(t *testing.T) {
+	data := workbookBytes(t, [][]any{
+		{"Email", "Unused", " Age ", "Name"},
+		{"a@example.com", "x", "30", "Alice"},
+		{"b@example.com"},
+	})
+
+	rows, err := ReadColumns(bytes.NewReader(data), "Sheet1", []string{"Name", "Email", "Age"})
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]string{
+		{"Name": "Alice", "Email": "a@example.com", "Age": "30"},
+		{"Name": "", "Email": "b@example.com", "Age": ""},
+	}, rows)
+}
+
+func TestReadColumns_SkipsEmptyRows(t *testing.T) {
+	data := workbookBytes(t, [][]any{{}, {"Name"}, {"a"}, {}, {"b"}})
+
+	rows, err := ReadColumns(bytes.NewReader(data), "Sheet1", []string{"Name"})
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]string{{"Name": "a"}, {"Name": "b"}}, rows)
+}
+
+func TestReadColumns_MissingHeader(t *testing.T) {
+	data := workbookBytes(t, [][]any{
+		{"Name", "Age"},
+		{"Alice", "30"},
+	})
+
+	_, err := ReadColumns(bytes.NewReader(data), "Sheet1", []string{"Name", "Email"})
+	assert.ErrorIs(t, err, errMissingHeader)
+	assert.ErrorContains(t, err, `"Email"`)
+
+	_, err = ReadColumns(bytes.NewReader(workbookBytes(t, nil)), "Sheet1", []string{"Name"})
+	assert.ErrorIs(t, err, errMissingHeader)
+}
@@ -0,0 +1,126 @@
+package excel
+
+import "strings"
+
+type config struct {
+	skipEmptyRows bool
+	trimCells     bool
+	startRow      int
+	maxRows       int
+}
+
+// Option configures how rows are read from a sheet.
+type Option func(*config)
+
+// WithSkipEmptyRows drops rows whose cells are all empty. By default empty
+// rows are kept so row positions line up with the sheet.
+func WithSkipEmptyRows() Option {
+	return func(c *config) {
+		c.skipEmptyRows = true
+	}
+}
+
+// WithTrimCells trims leading and trailing white space from every cell. Rows
+// that contain only white space count as empty after trimming.
+func WithTrimCells(trim bool) Option {
+	return func(c *config) {
+		c.trimCells = trim
+	}
+}
+
+// WithStartRow starts reading at the 1-based row n, skipping banner rows above
+// the real header. A start beyond the last row yields no rows. Values below 1
+// are ignored.
+func WithStartRow(n int) Option {
+	return func(c *config) {
+		if n > 0 {
+			c.startRow = n
+		}
+	}
+}
+
+// WithMaxRows returns at most n rows, counted after the start row and empty
+// row filtering are applied. Values below 1 are ignored.
+func WithMaxRows(n int) Option {
+	return func(c *config) {
+		if n > 0 {
+			c.maxRows = n
+		}
+	}
+}
+
+func newConfig(opts ...Option) *config {
+	cfg := &config{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// apply rewrites rows in place according to the config and returns the
+// resulting rows.
+func (c *config) apply(rows [][]string) [][]string {
+	if c.startRow > 1 {
+		rows = rows[min(c.startRow-1, len(rows)):]
+	}
+
+	if c.trimCells {
+		for _, row := range rows {
+			for i, cell := range row {
+				row[i] = strings.TrimSpace(cell)
+			}
+		}
+	}
+
+	if c.skipEmptyRows {
+		kept := rows[:0]
+		for _, row := range rows {
+			if !isRowEmpty(row) {
+				kept = append(kept, row)
+			}
+		}
+		rows = kept
+	}
+
+	if c.maxRows > 0 && len(rows) > c.maxRows {
+		rows = rows[:c.maxRows]
+	}
+	return rows
+}
+
+// walk wraps fn so that streamed rows are filtered the same way apply filters
+// a loaded sheet. Once maxRows rows were passed on, it returns errStopWalk.
+func (c *config) walk(fn func(int, []string) error) func(int, []string) error {
+	passed := 0
+	return func(idx int, row []string) error {
+		if idx < c.startRow {
+			return nil
+		}
+		if c.trimCells {
+			for i, cell := range row {
+				row[i] = strings.TrimSpace(cell)
+			}
+		}
+		if c.skipEmptyRows && isRowEmpty(row) {
+			return nil
+		}
+
+		if err := fn(idx, row); err != nil {
+			return err
+		}
+		passed++
+		if c.maxRows > 0 && passed >= c.maxRows {
+			return errStopWalk
+		}
+		return nil
+	}
+}
+
+func isRowEmpty(row []string) bool {
+	for _, cell := range row {
+		if cell != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,151 @@
+package excel
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sheetWithBlanks(t *testing.T) string {
+	data := workbookBytes(t, [][]any{
+		{"id", "name"},
+		{},
+		{"1", " Alice "},
+		{"  ", ""},
+		{"2", "Bob"},
+	})
+	path := filepath.Join(t.TempDir(), "blanks.xlsx")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestReadSheet_KeepsEmptyRowsByDefault(t *testing.T) {
+	rows, err := ReadSheet(sheetWithBlanks(t), "Sheet1")
+	require.NoError(t, err)
+
+	// One row per row of the used range A1:B5, so indexes match the sheet.
+	require.Len(t, rows, 5)
+	assert.Empty(t, rows[1])
+	assert.Equal(t, []string{"1", " Alice "}, rows[2])
+	assert.Equal(t, []string{"  "}, rows[3])
+}
+
+func TestReadSheet_Options(t *testing.T) {
+	path := sheetWithBlanks(t)
+
+	tests := []struct {
+		name string
+		opts []Option
+		want [][]string
+	}{
+		{
+			name: "skip empty rows",
+			opts: []Option{WithSkipEmptyRows()},
+			want: [][]string{{"id", "name"}, {"1", " Alice "}, {"  "}, {"2", "Bob"}},
+		},
+		{
+			name: "trim cells",
+			opts: []Option{WithTrimCells(true)},
+			want: [][]string{{"id", "name"}, nil, {"1", "Alice"}, {""}, {"2", "Bob"}},
+		},
+		{
+			name: "trim and skip",
+			opts: []Option{WithTrimCells(true), WithSkipEmptyRows()},
+			want: [][]string{{"id", "name"}, {"1", "Alice"}, {"2", "Bob"}},
+		},
+		{
+			name: "trim disabled",
+			opts: []Option{WithTrimCells(true), WithTrimCells(false)},
+			want: [][]string{{"id", "name"}, nil, {"1", " Alice "}, {"  "}, {"2", "Bob"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := ReadSheet(path, "Sheet1", tt.opts...)
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.want), len(rows))
+			for i := range tt.want {
+				if len(tt.want[i]) == 0 {
+					assert.Empty(t, rows[i])
+					continue
+				}
+				assert.Equal(t, tt.want[i], rows[i])
+			}
+		})
+	}
+}
+
+func TestWorkbook_ReadAll_Options(t *testing.T) {
+	data := workbookBytes(t, [][]any{{"a"}, {}, {"b"}})
+
+	wb, err := OpenReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, wb.Close())
+	}()
+
+	all, err := wb.ReadAll(WithSkipEmptyRows())
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"a"}, {"b"}}, all["Sheet1"])
+}
+
+func TestReadSheet_StartRowAndMaxRows(t *testing.T) {
+	data := workbookBytes(t, [][]any{
+		{"Quarterly report"},
+		{},
+		{"id", "name"},
+		{"1", "Alice"},
+		{"2", "Bob"},
+		{"3", "Carol"},
+	})
+	path := filepath.Join(t.TempDir(), "banner.xlsx")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	tests := []struct {
+		name string
+		opts []Option
+		want [][]string
+	}{
+		{
+			name: "start at header",
+			opts: []Option{WithStartRow(3)},
+			want: [][]string{{"id", "name"}, {"1", "Alice"}, {"2", "Bob"}, {"3", "Carol"}},
+		},
+		{
+			name: "cap rows",
+			opts: []Option{WithStartRow(3), WithMaxRows(2)},
+			want: [][]string{{"id", "name"}, {"1", "Alice"}},
+		},
+		{
+			name: "cap counts rows after skipping empties",
+			opts: []Option{WithSkipEmptyRows(), WithMaxRows(2)},
+			want: [][]string{{"Quarterly report"}, {"id", "name"}},
+		},
+		{
+			name: "cap above row count",
+			opts: []Option{WithStartRow(5), WithMaxRows(10)},
+			want: [][]string{{"2", "Bob"}, {"3", "Carol"}},
+		},
+		{
+			name: "start beyond data",
+			opts: []Option{WithStartRow(100)},
+			want: [][]string{},
+		},
+		{
+			name: "non-positive values ignored",
+			opts: []Option{WithStartRow(0), WithMaxRows(-1), WithSkipEmptyRows()},
+			want: [][]string{{"Quarterly report"}, {"id", "name"}, {"1", "Alice"}, {"2", "Bob"}, {"3", "Carol"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := ReadSheet(path, "Sheet1", tt.opts...)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rows)
+		})
+	}
+}
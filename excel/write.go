@@ -0,0 +1,255 @@
+package excel
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	// defaultSheetName is the sheet every new excelize workbook starts with.
+	defaultSheetName = "Sheet1"
+
+	// maxExactInt is the largest integer Excel stores without rounding: it
+	// keeps 15 significant digits, so longer integers are written as text.
+	maxExactInt = 999_999_999_999_999
+)
+
+var errDuplicateSheet = errors.New("excel: duplicate sheet name")
+
+// Write creates a workbook with one sheet per key of sheets, in name order, and
+// writes it to w as .xlsx. Sheet names follow Excel's rules: 1 to 31
+// characters, none of :\/?*[] and no leading or trailing single quote. Names
+// that differ only in case collide. An empty map writes a single empty sheet.
+func Write(w io.Writer, sheets map[string][][]string) error {
+	if w == nil {
+		return errNilWriter
+	}
+
+	names := slices.Sorted(maps.Keys(sheets))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		key := strings.ToLower(name)
+		if seen[key] {
+			return fmt.Errorf("%w: %q", errDuplicateSheet, name)
+		}
+		seen[key] = true
+	}
+
+	return writeWorkbook(w, names, func(sw *excelize.StreamWriter, name string) error {
+		for i, row := range sheets[name] {
+			values := make([]any, len(row))
+			for j, s := range row {
+				values[j] = s
+			}
+			if err := setStreamRow(sw, i+1, values); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// WriteRecords writes rows to w as a single-sheet .xlsx workbook. Fields are
+// placed by their `excel` column tag, the same tag Parse reads. A struct
+// without `excel` tags is laid out by its `header` tags instead, one column
+// per field in declaration order. The first row is a header holding each
+// field's `header` tag name, or the field name when it has none, so the
+// output reads back with ReadRecords or, for `excel`-tagged structs, with
+// Parse after skipping the header. time.Time fields are formatted with the
+// header tag's layout or time.DateOnly; nil pointers leave the cell empty.
+// Integers longer than the 15 digits Excel keeps are written as text. A
+// struct with neither tag returns errInvalidTarget.
+func WriteRecords[T any](w io.Writer, name string, rows []T) error {
+	if w == nil {
+		return errNilWriter
+	}
+
+	info, err := getStructInfo[T]()
+	if err != nil {
+		return err
+	}
+	cols, err := recordColumns(info)
+	if err != nil {
+		return err
+	}
+
+	width := 0
+	if len(cols) > 0 {
+		width = cols[len(cols)-1].col + 1
+	}
+	header := make([]any, width)
+	for _, c := range cols {
+		header[c.col] = c.header
+	}
+
+	return writeWorkbook(w, []string{name}, func(sw *excelize.StreamWriter, _ string) error {
+		if err := setStreamRow(sw, 1, header); err != nil {
+			return err
+		}
+
+		for i, row := range rows {
+			v := reflect.ValueOf(row)
+			if info.ptr {
+				if v.IsNil() {
+					continue
+				}
+				v = v.Elem()
+			}
+
+			values := make([]any, width)
+			for _, c := range cols {
+				cell, err := cellValue(v.Field(c.index), c.layout)
+				if err != nil {
+					return fmt.Errorf("row %d: column %s: %w", i+2, columnName(c.col), err)
+				}
+				values[c.col] = cell
+			}
+			if err := setStreamRow(sw, i+2, values); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// recordColumn is where WriteRecords puts one struct field.
+type recordColumn struct {
+	col    int
+	index  int
+	header string
+	layout string
+}
+
+// recordColumns returns the columns WriteRecords fills, ordered by column.
+func recordColumns(info structInfo) ([]recordColumn, error) {
+	if len(info.fields) == 0 {
+		fields, err := headerFieldsOf(info.typ)
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) == 0 {
+			return nil, fmt.Errorf("%w: %v has no excel or header tags", errInvalidTarget, info.typ)
+		}
+		cols := make([]recordColumn, len(fields))
+		for i, f := range fields {
+			cols[i] = recordColumn{col: i, index: f.index, header: f.name, layout: f.layout}
+		}
+		return cols, nil
+	}
+
+	var cols []recordColumn
+	for _, col := range slices.Sorted(maps.Keys(info.fields)) {
+		field := info.typ.Field(info.fields[col].index)
+		c := recordColumn{col: col, index: info.fields[col].index, header: field.Name, layout: defaultTimeLayout}
+
+		if tag := field.Tag.Get(headerTagKey); tag != "" && tag != "-" {
+			hf, err := parseHeaderTag(tag)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", field.Name, err)
+			}
+			c.header = hf.name
+			c.layout = hf.layout
+		}
+		cols = append(cols, c)
+	}
+	return cols, nil
+}
+
+// writeWorkbook creates names in order, lets fill stream each sheet, and
+// writes the workbook to w.
+func writeWorkbook(w io.Writer, names []string, fill func(*excelize.StreamWriter, string) error) (err error) {
+	f := excelize.NewFile()
+	defer func() {
+		if closeErr := f.Close(); err == nil && closeErr != nil {
+			err = fmt.Errorf("close workbook: %w", closeErr)
+		}
+	}()
+
+	for _, name := range names {
+		if _, err := f.NewSheet(name); err != nil {
+			return fmt.Errorf("sheet %q: %w", name, err)
+		}
+	}
+	if len(names) > 0 && !slices.ContainsFunc(names, func(name string) bool {
+		return strings.EqualFold(name, defaultSheetName)
+	}) {
+		if err := f.DeleteSheet(defaultSheetName); err != nil {
+			return err
+		}
+	}
+
+	for _, name := range names {
+		sw, err := f.NewStreamWriter(name)
+		if err != nil {
+			return fmt.Errorf("sheet %q: %w", name, err)
+		}
+		if err := fill(sw, name); err != nil {
+			return fmt.Errorf("sheet %q: %w", name, err)
+		}
+		if err := sw.Flush(); err != nil {
+			return fmt.Errorf("sheet %q: %w", name, err)
+		}
+	}
+
+	if _, err := f.WriteTo(w); err != nil {
+		return fmt.Errorf("write workbook: %w", err)
+	}
+	return nil
+}
+
+func setStreamRow(sw *excelize.StreamWriter, row int, values []any) error {
+	cell, err := excelize.CoordinatesToCellName(1, row)
+	if err != nil {
+		return err
+	}
+	return sw.SetRow(cell, values)
+}
+
+// cellValue converts a struct field into a value excelize can store so that
+// parseValue reads it back unchanged.
+func cellValue(v reflect.Value, layout string) (any, error) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Type() == timeType {
+		t := v.Interface().(time.Time)
+		if t.IsZero() {
+			return nil, nil
+		}
+		return t.Format(layout), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n := v.Int(); n > maxExactInt || n < -maxExactInt {
+			return strconv.FormatInt(n, 10), nil
+		}
+		return v.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n := v.Uint(); n > maxExactInt {
+			return strconv.FormatUint(n, 10), nil
+		}
+		return v.Uint(), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.Bool:
+		return v.Bool(), nil
+	default:
+		return nil, fmt.Errorf("%w: %v", errUnsupported, v.Type())
+	}
+}
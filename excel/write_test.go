@@ -0,0 +1,214 @@
+package excel
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestWrite_RoundTrip(t *testing.T) {
+	sheets := map[string][][]string{
+		"Users":  {{"id", "name"}, {"1", "Alice"}, {"2", "Bob"}},
+		"Orders": {{"order"}, {"A-1"}},
+		"Empty":  nil,
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, sheets))
+
+	wb, err := OpenReader(&buf)
+	require.NoError(t, err)
+	defer wb.Close()
+
+	assert.Equal(t, []string{"Empty", "Orders", "Users"}, wb.Sheets())
+
+	got, err := wb.ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, sheets["Users"], got["Users"])
+	assert.Equal(t, sheets["Orders"], got["Orders"])
+	assert.Empty(t, got["Empty"])
+}
+
+func TestWrite_KeepsDefaultSheetName(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, map[string][][]string{
+		"Sheet1": {{"a"}},
+		"Data":   {{"b"}},
+	}))
+
+	wb, err := OpenReader(&buf)
+	require.NoError(t, err)
+	defer wb.Close()
+	assert.ElementsMatch(t, []string{"Data", "Sheet1"}, wb.Sheets())
+}
+
+func TestWrite_EmptyMap(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, nil))
+
+	wb, err := OpenReader(&buf)
+	require.NoError(t, err)
+	defer wb.Close()
+	assert.Equal(t, []string{"Sheet1"}, wb.Sheets())
+}
+
+func TestWrite_InvalidSheetNames(t *testing.T) {
+	tests := []struct {
+		name    string
+		sheet   string
+		wantErr error
+	}{
+		{name: "blank", sheet: "", wantErr: excelize.ErrSheetNameBlank},
+		{name: "too long", sheet: strings.Repeat("x", 32), wantErr: excelize.ErrSheetNameLength},
+		{name: "illegal character", sheet: "a/b", wantErr: excelize.ErrSheetNameInvalid},
+		{name: "single quote", sheet: "'quoted'", wantErr: excelize.ErrSheetNameSingleQuote},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Write(&bytes.Buffer{}, map[string][][]string{tt.sheet: {{"x"}}})
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestWrite_DuplicateSheetNames(t *testing.T) {
+	err := Write(&bytes.Buffer{}, map[string][][]string{"Data": nil, "data": nil})
+	assert.ErrorIs(t, err, errDuplicateSheet)
+}
+
+func TestWrite_NilWriter(t *testing.T) {
+	assert.ErrorIs(t, Write(nil, nil), errNilWriter)
+	assert.ErrorIs(t, WriteRecords[employee](nil, "Sheet1", nil), errNilWriter)
+}
+
+type reportRow struct {
+	Name   string    `excel:"A" header:"Name,required"`
+	Age    int       `excel:"B" header:"Age"`
+	Salary float64   `excel:"C" header:"Salary"`
+	Active bool      `excel:"D" header:"Active"`
+	Joined time.Time `excel:"E" header:"Joined,layout=Jan 2, 2006"`
+	Note   *string   `excel:"F" header:"Note"`
+	Code   uint8     `excel:"H"`
+}
+
+func TestWriteRecords_RoundTrip(t *testing.T) {
+	note := "remote"
+	rows := []reportRow{
+		{Name: "Alice", Age: 30, Salary: 1234.5, Active: true, Joined: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), Note: &note, Code: 7},
+		{Name: "Bob"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteRecords(&buf, "Report", rows))
+	data := buf.Bytes()
+
+	raw, err := sheetRows(data, "Report")
+	require.NoError(t, err)
+	require.Len(t, raw, 3)
+	assert.Equal(t, []string{"Name", "Age", "Salary", "Active", "Joined", "Note", "", "Code"}, raw[0])
+
+	got, err := ReadRecords[reportRow](bytes.NewReader(data), "Report")
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, rows[0].Name, got[0].Name)
+	assert.Equal(t, rows[0].Age, got[0].Age)
+	assert.InDelta(t, rows[0].Salary, got[0].Salary, 0.0001)
+	assert.True(t, got[0].Active)
+	assert.Equal(t, rows[0].Joined, got[0].Joined)
+	require.NotNil(t, got[0].Note)
+	assert.Equal(t, note, *got[0].Note)
+
+	assert.Equal(t, "Bob", got[1].Name)
+	assert.True(t, got[1].Joined.IsZero())
+	assert.Nil(t, got[1].Note)
+
+	assert.Equal(t, "7", raw[1][7])
+}
+
+func TestWriteRecords_LargeIntegers(t *testing.T) {
+	type big struct {
+		ID       int64  `excel:"A" header:"ID"`
+		Negative int64  `excel:"B" header:"Negative"`
+		Unsigned uint64 `excel:"C" header:"Unsigned"`
+		Small    int64  `excel:"D" header:"Small"`
+	}
+	rows := []big{{ID: 9007199254740993, Negative: -1234567890123456, Unsigned: 18446744073709551615, Small: 999999999999999}}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteRecords(&buf, "Sheet1", rows))
+
+	got, err := ReadRecords[big](bytes.NewReader(buf.Bytes()), "Sheet1")
+	require.NoError(t, err)
+	assert.Equal(t, rows, got)
+}
+
+func TestWriteRecords_HeaderTagsOnly(t *testing.T) {
+	type contact struct {
+		Name   string    `header:"Name"`
+		Skip   string    `header:"-"`
+		Email  string    `header:"Email,required"`
+		Joined time.Time `header:"Joined,layout=2006/01/02"`
+	}
+	rows := []contact{{Name: "Alice", Skip: "x", Email: "a@example.com", Joined: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)}}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteRecords(&buf, "Sheet1", rows))
+
+	raw, err := sheetRows(buf.Bytes(), "Sheet1")
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"Name", "Email", "Joined"}, {"Alice", "a@example.com", "2024/03/05"}}, raw)
+
+	got, err := ReadRecords[contact](bytes.NewReader(buf.Bytes()), "Sheet1")
+	require.NoError(t, err)
+	rows[0].Skip = ""
+	assert.Equal(t, rows, got)
+}
+
+func TestWriteRecords_PointerRows(t *testing.T) {
+	rows := []*reportRow{{Name: "Alice"}, nil, {Name: "Carol"}}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteRecords(&buf, "Sheet1", rows))
+
+	raw, err := sheetRows(buf.Bytes(), "Sheet1")
+	require.NoError(t, err)
+	require.Len(t, raw, 4)
+	assert.Equal(t, "Alice", raw[1][0])
+	assert.Empty(t, raw[2])
+	assert.Equal(t, "Carol", raw[3][0])
+}
+
+func TestWriteRecords_Errors(t *testing.T) {
+	err := WriteRecords(&bytes.Buffer{}, "Sheet1", []int{1})
+	assert.ErrorIs(t, err, errInvalidTarget)
+
+	err = WriteRecords(&bytes.Buffer{}, "bad:name", []reportRow{{Name: "x"}})
+	assert.ErrorIs(t, err, excelize.ErrSheetNameInvalid)
+
+	type untagged struct {
+		Name string
+	}
+	err = WriteRecords(&bytes.Buffer{}, "Sheet1", []untagged{{Name: "x"}})
+	assert.ErrorIs(t, err, errInvalidTarget)
+
+	type unsupported struct {
+		Tags []string `excel:"A"`
+	}
+	err = WriteRecords(&bytes.Buffer{}, "Sheet1", []unsupported{{Tags: []string{"a"}}})
+	assert.ErrorIs(t, err, errUnsupported)
+}
+
+// sheetRows collects every row of a sheet in a workbook held in memory.
+func sheetRows(data []byte, name string) ([][]string, error) {
+	var rows [][]string
+	err := WalkReader(bytes.NewReader(data), name, func(_ int, row []string) error {
+		rows = append(rows, row)
+		return nil
+	})
+	return rows, err
+}
@@ -0,0 +1,79 @@
+package ptr
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Optional distinguishes a JSON field that is absent from one that is
+// explicitly null and one that carries a value, as PATCH-style payloads need.
+// The zero value is absent.
+//
+// Absent values marshal as null. To drop them from the output instead, tag the
+// field with omitzero (Go 1.24 or later), which uses IsZero.
+type Optional[T any] struct {
+	value T
+	set   bool
+	valid bool
+}
+
+// Some returns an Optional holding v.
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{value: v, set: true, valid: true}
+}
+
+// None returns an Optional that is explicitly null.
+func None[T any]() Optional[T] {
+	return Optional[T]{set: true}
+}
+
+// Get returns the held value and true, or the zero value and false when o is
+// absent or null.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.valid
+}
+
+// Ptr returns a pointer to a copy of the held value, or nil when o is absent or
+// null.
+func (o Optional[T]) Ptr() *T {
+	return ToIf(o.valid, o.value)
+}
+
+// IsSet reports whether o was given a value or an explicit null.
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// IsNull reports whether o is explicitly null.
+func (o Optional[T]) IsNull() bool {
+	return o.set && !o.valid
+}
+
+// IsZero reports whether o is absent.
+func (o Optional[T]) IsZero() bool {
+	return !o.set
+}
+
+// MarshalJSON encodes the held value, or null when o is absent or null.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON marks o as set and decodes data, treating a JSON null as an
+// explicit null. It is not called for absent fields, which stay absent.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
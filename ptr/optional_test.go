@@ -0,0 +1,105 @@
+package ptr
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type patch struct {
+	Name Optional[string] `json:"name"`
+	Age  Optional[int]    `json:"age"`
+}
+
+func TestOptional_States(t *testing.T) {
+	t.Run("absent", func(t *testing.T) {
+		var o Optional[int]
+		v, ok := o.Get()
+		assert.False(t, ok)
+		assert.Equal(t, 0, v)
+		assert.False(t, o.IsSet())
+		assert.False(t, o.IsNull())
+		assert.True(t, o.IsZero())
+		assert.Nil(t, o.Ptr())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		o := None[int]()
+		_, ok := o.Get()
+		assert.False(t, ok)
+		assert.True(t, o.IsSet())
+		assert.True(t, o.IsNull())
+		assert.False(t, o.IsZero())
+		assert.Nil(t, o.Ptr())
+	})
+
+	t.Run("present", func(t *testing.T) {
+		o := Some(0)
+		v, ok := o.Get()
+		assert.True(t, ok)
+		assert.Equal(t, 0, v)
+		assert.True(t, o.IsSet())
+		assert.False(t, o.IsNull())
+		require.NotNil(t, o.Ptr())
+		assert.Equal(t, 0, *o.Ptr())
+	})
+}
+
+func TestOptional_UnmarshalJSON(t *testing.T) {
+	t.Run("absent", func(t *testing.T) {
+		var p patch
+		require.NoError(t, json.Unmarshal([]byte(`{"age":3}`), &p))
+		assert.False(t, p.Name.IsSet())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		var p patch
+		require.NoError(t, json.Unmarshal([]byte(`{"name":null}`), &p))
+		assert.True(t, p.Name.IsNull())
+		assert.False(t, p.Age.IsSet())
+	})
+
+	t.Run("present", func(t *testing.T) {
+		var p patch
+		require.NoError(t, json.Unmarshal([]byte(`{"name":"","age":0}`), &p))
+		name, ok := p.Name.Get()
+		assert.True(t, ok)
+		assert.Equal(t, "", name)
+		age, ok := p.Age.Get()
+		assert.True(t, ok)
+		assert.Equal(t, 0, age)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		var p patch
+		assert.Error(t, json.Unmarshal([]byte(`{"age":"three"}`), &p))
+	})
+}
+
+func TestOptional_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		in   patch
+		json string
+	}{
+		{"absent", patch{}, `{"name":null,"age":null}`},
+		{"null", patch{Name: None[string](), Age: None[int]()}, `{"name":null,"age":null}`},
+		{"present", patch{Name: Some("Alice"), Age: Some(30)}, `{"name":"Alice","age":30}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.in)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.json, string(data))
+
+			var out patch
+			require.NoError(t, json.Unmarshal(data, &out))
+			inName, inOK := tt.in.Name.Get()
+			outName, outOK := out.Name.Get()
+			assert.Equal(t, inOK, outOK)
+			assert.Equal(t, inName, outName)
+		})
+	}
+}
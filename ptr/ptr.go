@@ -27,3 +27,53 @@ func Deref[T any](p *T) T {
 	var zero T
 	return DerefOr(p, zero)
 }
+
+func Coalesce[T any](ptrs ...*T) *T {
+	for _, p := range ptrs {
+		if p != nil {
+			return p
+		}
+	}
+	return nil
+}
+
+func FirstNonNil[T any](ptrs ...*T) (T, bool) {
+	if p := Coalesce(ptrs...); p != nil {
+		return *p, true
+	}
+	var zero T
+	return zero, false
+}
+
+func Equal[T comparable](a, b *T) bool {
+	return EqualFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+func EqualFunc[T any](a, b *T, eq func(T, T) bool) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return eq(*a, *b)
+}
+
+func ToSlice[T any](in []T) []*T {
+	if in == nil {
+		return nil
+	}
+	out := make([]*T, len(in))
+	for i, v := range in {
+		out[i] = To(v)
+	}
+	return out
+}
+
+func DerefSliceOr[T any](in []*T, defaultVal T) []T {
+	if in == nil {
+		return nil
+	}
+	out := make([]T, len(in))
+	for i, p := range in {
+		out[i] = DerefOr(p, defaultVal)
+	}
+	return out
+}
@@ -1,6 +1,7 @@
 package ptr
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -290,3 +291,124 @@ func BenchmarkDeref_Nil(b *testing.B) {
 		Deref(val)
 	}
 }
+
+func TestCoalesce(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		assert.Nil(t, Coalesce[int]())
+	})
+
+	t.Run("all nil", func(t *testing.T) {
+		assert.Nil(t, Coalesce[int](nil, nil, nil))
+	})
+
+	t.Run("first non-nil in middle", func(t *testing.T) {
+		a, b := 1, 2
+		result := Coalesce(nil, &a, &b)
+		assert.Same(t, &a, result)
+	})
+
+	t.Run("first argument", func(t *testing.T) {
+		a := "hello"
+		assert.Same(t, &a, Coalesce(&a, nil))
+	})
+}
+
+func TestFirstNonNil(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		result, ok := FirstNonNil[string]()
+		assert.False(t, ok)
+		assert.Equal(t, "", result)
+	})
+
+	t.Run("all nil", func(t *testing.T) {
+		result, ok := FirstNonNil[int](nil, nil)
+		assert.False(t, ok)
+		assert.Equal(t, 0, result)
+	})
+
+	t.Run("first non-nil in middle", func(t *testing.T) {
+		result, ok := FirstNonNil(nil, To(0), To(5))
+		assert.True(t, ok)
+		assert.Equal(t, 0, result)
+	})
+}
+
+func TestEqual(t *testing.T) {
+	t.Run("both nil", func(t *testing.T) {
+		assert.True(t, Equal[int](nil, nil))
+	})
+
+	t.Run("one nil", func(t *testing.T) {
+		assert.False(t, Equal(To(1), nil))
+		assert.False(t, Equal(nil, To(1)))
+	})
+
+	t.Run("equal values", func(t *testing.T) {
+		assert.True(t, Equal(To("a"), To("a")))
+	})
+
+	t.Run("unequal values", func(t *testing.T) {
+		assert.False(t, Equal(To(1), To(2)))
+	})
+}
+
+func TestEqualFunc(t *testing.T) {
+	eq := slices.Equal[[]int]
+
+	t.Run("both nil", func(t *testing.T) {
+		assert.True(t, EqualFunc(nil, nil, eq))
+	})
+
+	t.Run("one nil", func(t *testing.T) {
+		assert.False(t, EqualFunc(To([]int{1}), nil, eq))
+		assert.False(t, EqualFunc(nil, To([]int{1}), eq))
+	})
+
+	t.Run("equal values", func(t *testing.T) {
+		assert.True(t, EqualFunc(To([]int{1, 2}), To([]int{1, 2}), eq))
+	})
+
+	t.Run("unequal values", func(t *testing.T) {
+		assert.False(t, EqualFunc(To([]int{1, 2}), To([]int{2, 1}), eq))
+	})
+}
+
+func TestToSlice(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, ToSlice[int](nil))
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		result := ToSlice([]int{})
+		assert.NotNil(t, result)
+		assert.Empty(t, result)
+	})
+
+	t.Run("independent addresses", func(t *testing.T) {
+		in := []int{1, 2, 3}
+		result := ToSlice(in)
+		require.Len(t, result, 3)
+
+		*result[0] = 100
+		assert.Equal(t, 100, *result[0])
+		assert.Equal(t, 2, *result[1])
+		assert.Equal(t, 3, *result[2])
+		assert.Equal(t, []int{1, 2, 3}, in)
+	})
+}
+
+func TestDerefSliceOr(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, DerefSliceOr[int](nil, 0))
+	})
+
+	t.Run("nil entries use default", func(t *testing.T) {
+		result := DerefSliceOr([]*string{To("a"), nil, To("")}, "default")
+		assert.Equal(t, []string{"a", "default", ""}, result)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		in := []int{4, 5, 6}
+		assert.Equal(t, in, DerefSliceOr(ToSlice(in), 0))
+	})
+}
@@ -0,0 +1,100 @@
+package time
+
+import "time"
+
+// BusinessCalendar decides which days count as business days. The zero value
+// treats Saturday and Sunday as the weekend and has no holidays.
+type BusinessCalendar struct {
+	// Weekend lists the non-working weekdays. Nil means Saturday and Sunday.
+	Weekend []time.Weekday
+	// Holidays are matched by calendar date; the time of day and location of
+	// each key are ignored.
+	Holidays map[time.Time]bool
+}
+
+type calendarDate struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func dateOf(t time.Time) calendarDate {
+	y, m, d := t.Date()
+	return calendarDate{year: y, month: m, day: d}
+}
+
+// IsBusinessDay reports whether t is neither a Saturday, a Sunday, nor a date
+// in holidays.
+func IsBusinessDay(t time.Time, holidays map[time.Time]bool) bool {
+	return BusinessCalendar{Holidays: holidays}.IsBusinessDay(t)
+}
+
+// AddBusinessDays moves t forward by days business days, or backward when days
+// is negative, skipping weekends and holidays. The time of day is kept, and
+// days == 0 returns t unchanged.
+func AddBusinessDays(t time.Time, days int, holidays map[time.Time]bool) time.Time {
+	return BusinessCalendar{Holidays: holidays}.AddBusinessDays(t, days)
+}
+
+// IsBusinessDay reports whether t is neither a weekend day nor a holiday.
+func (c BusinessCalendar) IsBusinessDay(t time.Time) bool {
+	return c.isBusinessDay(t, c.holidayDates())
+}
+
+// AddBusinessDays is like the package-level AddBusinessDays but uses c. When
+// Weekend covers all seven weekdays no day can be a business day, so t is
+// returned unchanged.
+func (c BusinessCalendar) AddBusinessDays(t time.Time, days int) time.Time {
+	if !c.hasWorkingWeekday() {
+		return t
+	}
+
+	step := 1
+	if days < 0 {
+		step, days = -1, -days
+	}
+
+	holidays := c.holidayDates()
+	for days > 0 {
+		t = t.AddDate(0, 0, step)
+		if c.isBusinessDay(t, holidays) {
+			days--
+		}
+	}
+	return t
+}
+
+func (c BusinessCalendar) isBusinessDay(t time.Time, holidays map[calendarDate]bool) bool {
+	return !c.isWeekend(t.Weekday()) && !holidays[dateOf(t)]
+}
+
+func (c BusinessCalendar) isWeekend(day time.Weekday) bool {
+	if c.Weekend == nil {
+		return day == time.Saturday || day == time.Sunday
+	}
+	for _, w := range c.Weekend {
+		if w == day {
+			return true
+		}
+	}
+	return false
+}
+
+func (c BusinessCalendar) hasWorkingWeekday() bool {
+	for day := range time.Weekday(7) {
+		if !c.isWeekend(day) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c BusinessCalendar) holidayDates() map[calendarDate]bool {
+	dates := make(map[calendarDate]bool, len(c.Holidays))
+	for h, ok := range c.Holidays {
+		if ok {
+			dates[dateOf(h)] = true
+		}
+	}
+	return dates
+}
@@ -0,0 +1,96 @@
+package time
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddBusinessDays(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*60*60)
+	holidays := map[time.Time]bool{
+		// Stored with a time of day and in UTC; only the date matters.
+		time.Date(2024, 3, 20, 15, 30, 0, 0, time.UTC): true,
+		time.Date(2024, 3, 21, 0, 0, 0, 0, time.UTC):   false,
+	}
+
+	tests := []struct {
+		name     string
+		input    time.Time
+		days     int
+		expected time.Time
+	}{
+		{
+			name:     "friday plus one lands on monday",
+			input:    time.Date(2024, 3, 15, 9, 0, 0, 0, loc),
+			days:     1,
+			expected: time.Date(2024, 3, 18, 9, 0, 0, 0, loc),
+		},
+		{
+			name:     "skips holiday",
+			input:    time.Date(2024, 3, 19, 9, 0, 0, 0, loc),
+			days:     1,
+			expected: time.Date(2024, 3, 21, 9, 0, 0, 0, loc),
+		},
+		{
+			name:     "skips weekend and holiday",
+			input:    time.Date(2024, 3, 15, 9, 0, 0, 0, loc),
+			days:     5,
+			expected: time.Date(2024, 3, 25, 9, 0, 0, 0, loc),
+		},
+		{
+			name:     "backwards from monday",
+			input:    time.Date(2024, 3, 18, 9, 0, 0, 0, loc),
+			days:     -1,
+			expected: time.Date(2024, 3, 15, 9, 0, 0, 0, loc),
+		},
+		{
+			name:     "zero from saturday",
+			input:    time.Date(2024, 3, 16, 9, 0, 0, 0, loc),
+			days:     0,
+			expected: time.Date(2024, 3, 16, 9, 0, 0, 0, loc),
+		},
+		{
+			name:     "saturday plus one",
+			input:    time.Date(2024, 3, 16, 9, 0, 0, 0, loc),
+			days:     1,
+			expected: time.Date(2024, 3, 18, 9, 0, 0, 0, loc),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, AddBusinessDays(tt.input, tt.days, holidays))
+		})
+	}
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	holidays := map[time.Time]bool{time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC): true}
+
+	assert.True(t, IsBusinessDay(time.Date(2024, 3, 19, 23, 0, 0, 0, time.UTC), holidays))
+	assert.False(t, IsBusinessDay(time.Date(2024, 3, 20, 23, 0, 0, 0, time.UTC), holidays))
+	assert.False(t, IsBusinessDay(time.Date(2024, 3, 16, 12, 0, 0, 0, time.UTC), nil))
+	assert.False(t, IsBusinessDay(time.Date(2024, 3, 17, 12, 0, 0, 0, time.UTC), nil))
+}
+
+func TestBusinessCalendar_CustomWeekend(t *testing.T) {
+	cal := BusinessCalendar{Weekend: []time.Weekday{time.Friday, time.Saturday}}
+
+	thursday := time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)
+	assert.False(t, cal.IsBusinessDay(thursday.AddDate(0, 0, 1)))
+	assert.True(t, cal.IsBusinessDay(thursday.AddDate(0, 0, 3)))
+	assert.Equal(t, time.Date(2024, 3, 17, 9, 0, 0, 0, time.UTC), cal.AddBusinessDays(thursday, 1))
+}
+
+func TestBusinessCalendar_AllWeekend(t *testing.T) {
+	cal := BusinessCalendar{Weekend: []time.Weekday{
+		time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday,
+	}}
+
+	start := time.Date(2024, 3, 14, 9, 0, 0, 0, time.UTC)
+	assert.False(t, cal.IsBusinessDay(start))
+	assert.Equal(t, start, cal.AddBusinessDays(start, 3))
+	assert.Equal(t, start, cal.AddBusinessDays(start, -3))
+}
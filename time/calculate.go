@@ -12,22 +12,78 @@ func EndOfDay(t time.Time) time.Time {
 	return time.Date(t.Year(), t.Month(), t.Day(), 23, 59, 59, 999999999, t.Location())
 }
 
+// DayBounds returns StartOfDay(t) and EndOfDay(t), the inclusive range of t's
+// day. Both carry t's location, so they suit a BETWEEN query in that zone.
+func DayBounds(t time.Time) (start, end time.Time) {
+	return StartOfDay(t), EndOfDay(t)
+}
+
+// StartOfDays returns StartOfDay of each element of ts, each in its own
+// location. A nil slice yields nil.
+func StartOfDays(ts []time.Time) []time.Time {
+	return mapTimes(ts, StartOfDay)
+}
+
+// EndOfDays returns EndOfDay of each element of ts, each in its own location. A
+// nil slice yields nil.
+func EndOfDays(ts []time.Time) []time.Time {
+	return mapTimes(ts, EndOfDay)
+}
+
+func mapTimes(ts []time.Time, fn func(time.Time) time.Time) []time.Time {
+	if ts == nil {
+		return nil
+	}
+	out := make([]time.Time, len(ts))
+	for i, t := range ts {
+		out[i] = fn(t)
+	}
+	return out
+}
+
+// StartOfHour returns the start of t's hour in t's location. Unlike
+// t.Truncate(time.Hour), it is correct in zones whose offset is not a whole
+// number of hours, and it keeps the right instant during a repeated DST hour.
+func StartOfHour(t time.Time) time.Time {
+	return t.Add(-time.Duration(t.Minute())*time.Minute - time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+}
+
+// EndOfHour returns the final nanosecond (HH:59:59.999999999) of t's hour in t's location.
+func EndOfHour(t time.Time) time.Time {
+	return StartOfHour(t).Add(time.Hour - time.Nanosecond)
+}
+
+// StartOfMinute returns the start of t's minute in t's location.
+func StartOfMinute(t time.Time) time.Time {
+	return t.Add(-time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+}
+
+// EndOfMinute returns the final nanosecond of t's minute in t's location.
+func EndOfMinute(t time.Time) time.Time {
+	return StartOfMinute(t).Add(time.Minute - time.Nanosecond)
+}
+
 // StartOfWeek returns the Monday of t's week in t's location.
 func StartOfWeek(t time.Time) time.Time {
-	weekday := int(t.Weekday())
-	if weekday == 0 {
-		weekday = 7
-	}
-	return StartOfDay(t.AddDate(0, 0, -(weekday - 1)))
+	return StartOfWeekOn(t, time.Monday)
 }
 
 // EndOfWeek returns the Sunday of t's week in t's location.
 func EndOfWeek(t time.Time) time.Time {
-	weekday := int(t.Weekday())
-	if weekday == 0 {
-		return EndOfDay(t)
-	}
-	return EndOfDay(t.AddDate(0, 0, 7-weekday))
+	return EndOfWeekOn(t, time.Monday)
+}
+
+// StartOfWeekOn returns midnight of the first day of t's week in t's location,
+// where weeks begin on first, for example time.Sunday or time.Monday.
+func StartOfWeekOn(t time.Time, first time.Weekday) time.Time {
+	offset := (int(t.Weekday()) - int(first) + 7) % 7
+	return StartOfDay(t.AddDate(0, 0, -offset))
+}
+
+// EndOfWeekOn returns the final nanosecond of the last day of t's week in t's
+// location, where weeks begin on first.
+func EndOfWeekOn(t time.Time, first time.Weekday) time.Time {
+	return EndOfDay(StartOfWeekOn(t, first).AddDate(0, 0, 6))
 }
 
 // StartOfMonth returns the first day of t's month at midnight in t's location.
@@ -40,6 +96,25 @@ func EndOfMonth(t time.Time) time.Time {
 	return EndOfDay(StartOfMonth(t).AddDate(0, 1, -1))
 }
 
+// Quarter returns t's calendar quarter, from 1 for January to March through 4
+// for October to December.
+func Quarter(t time.Time) int {
+	return (int(t.Month())-1)/3 + 1
+}
+
+// StartOfQuarter returns the first day of t's quarter at midnight in t's
+// location.
+func StartOfQuarter(t time.Time) time.Time {
+	month := time.Month((Quarter(t)-1)*3 + 1)
+	return time.Date(t.Year(), month, 1, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfQuarter returns the final nanosecond of the last month of t's quarter in
+// t's location.
+func EndOfQuarter(t time.Time) time.Time {
+	return EndOfMonth(StartOfQuarter(t).AddDate(0, 2, 0))
+}
+
 // StartOfYear returns January 1 of t's year at midnight in t's location.
 func StartOfYear(t time.Time) time.Time {
 	return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location())
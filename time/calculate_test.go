@@ -46,6 +46,44 @@ func TestDayBoundaries_PreserveLocation(t *testing.T) {
 	assert.Same(t, loc, EndOfDay(input).Location())
 }
 
+func TestDayBounds(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*60*60)
+	// 20:00 UTC on March 14 is already March 15 in UTC+8.
+	input := time.Date(2024, 3, 14, 20, 0, 0, 0, time.UTC).In(loc)
+
+	start, end := DayBounds(input)
+	assert.Equal(t, time.Date(2024, 3, 15, 0, 0, 0, 0, loc), start)
+	assert.Equal(t, time.Date(2024, 3, 15, 23, 59, 59, 999999999, loc), end)
+	assert.Same(t, loc, start.Location())
+	assert.Same(t, loc, end.Location())
+}
+
+func TestStartOfDaysAndEndOfDays(t *testing.T) {
+	east := time.FixedZone("UTC+8", 8*60*60)
+	west := time.FixedZone("UTC-5", -5*60*60)
+	input := []time.Time{
+		time.Date(2024, 3, 15, 14, 30, 0, 0, east),
+		time.Date(2024, 3, 15, 1, 0, 0, 0, west),
+		time.Date(2024, 3, 16, 23, 0, 0, 0, time.UTC),
+	}
+
+	starts := StartOfDays(input)
+	ends := EndOfDays(input)
+	assert.Len(t, starts, len(input))
+	assert.Len(t, ends, len(input))
+	for i, in := range input {
+		assert.Equal(t, StartOfDay(in), starts[i])
+		assert.Equal(t, EndOfDay(in), ends[i])
+		assert.Same(t, in.Location(), starts[i].Location())
+		assert.Same(t, in.Location(), ends[i].Location())
+	}
+	assert.Equal(t, time.Date(2024, 3, 15, 14, 30, 0, 0, east), input[0], "input is not modified")
+
+	assert.Nil(t, StartOfDays(nil))
+	assert.Nil(t, EndOfDays(nil))
+	assert.Empty(t, StartOfDays([]time.Time{}))
+}
+
 func TestEndOfDay(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -77,6 +115,63 @@ func TestEndOfDay(t *testing.T) {
 	}
 }
 
+func TestHourAndMinuteBoundaries(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*60*60)
+	input := time.Date(2024, 3, 15, 14, 30, 45, 123456789, loc)
+
+	assert.Equal(t, time.Date(2024, 3, 15, 14, 0, 0, 0, loc), StartOfHour(input))
+	assert.Equal(t, time.Date(2024, 3, 15, 14, 59, 59, 999999999, loc), EndOfHour(input))
+	assert.Equal(t, time.Date(2024, 3, 15, 14, 30, 0, 0, loc), StartOfMinute(input))
+	assert.Equal(t, time.Date(2024, 3, 15, 14, 30, 59, 999999999, loc), EndOfMinute(input))
+
+	for _, fn := range []func(time.Time) time.Time{StartOfHour, EndOfHour, StartOfMinute, EndOfMinute} {
+		once := fn(input)
+		assert.Same(t, loc, once.Location())
+		assert.Equal(t, once, fn(once))
+	}
+}
+
+func TestStartOfHour_NonHourOffset(t *testing.T) {
+	loc := time.FixedZone("UTC+5:45", 5*60*60+45*60)
+	input := time.Date(2024, 3, 15, 14, 20, 0, 0, loc)
+
+	assert.Equal(t, time.Date(2024, 3, 15, 14, 0, 0, 0, loc), StartOfHour(input))
+	// Truncate works on absolute time and lands on the UTC hour instead.
+	assert.Equal(t, time.Date(2024, 3, 15, 13, 45, 0, 0, loc), input.Truncate(time.Hour).In(loc))
+}
+
+func TestStartOfHour_DST(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("load location: %v", err)
+	}
+
+	t.Run("spring forward", func(t *testing.T) {
+		// 2024-03-10 02:00 EST jumps to 03:00 EDT.
+		input := time.Date(2024, 3, 10, 7, 30, 0, 0, time.UTC).In(ny)
+		assert.Equal(t, "03:30 EDT", input.Format("15:04 MST"))
+
+		start := StartOfHour(input)
+		assert.Equal(t, "03:00 EDT", start.Format("15:04 MST"))
+		assert.Equal(t, time.Date(2024, 3, 10, 7, 0, 0, 0, time.UTC), start.UTC())
+		assert.Equal(t, "03:59 EDT", EndOfHour(input).Format("15:04 MST"))
+	})
+
+	t.Run("fall back repeated hour", func(t *testing.T) {
+		// 01:00-02:00 happens twice on 2024-11-03, first in EDT then in EST.
+		first := time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC).In(ny)
+		second := time.Date(2024, 11, 3, 6, 30, 0, 0, time.UTC).In(ny)
+		assert.Equal(t, "01:30 EDT", first.Format("15:04 MST"))
+		assert.Equal(t, "01:30 EST", second.Format("15:04 MST"))
+
+		assert.Equal(t, time.Date(2024, 11, 3, 5, 0, 0, 0, time.UTC), StartOfHour(first).UTC())
+		assert.Equal(t, time.Date(2024, 11, 3, 6, 0, 0, 0, time.UTC), StartOfHour(second).UTC())
+		assert.Equal(t, "01:00 EST", StartOfHour(second).Format("15:04 MST"))
+		assert.Equal(t, time.Date(2024, 11, 3, 6, 59, 59, 999999999, time.UTC), EndOfHour(second).UTC())
+		assert.Equal(t, time.Date(2024, 11, 3, 6, 30, 0, 0, time.UTC), StartOfMinute(second).UTC())
+	})
+}
+
 func TestStartOfWeek(t *testing.T) {
 	loc := time.UTC
 
@@ -158,6 +253,87 @@ func TestEndOfWeek(t *testing.T) {
 	}
 }
 
+func TestStartOfWeekOn(t *testing.T) {
+	loc := time.UTC
+
+	tests := []struct {
+		name     string
+		input    time.Time
+		first    time.Weekday
+		expected time.Time
+	}{
+		{
+			name:     "sunday start on sunday",
+			input:    time.Date(2024, 3, 17, 14, 0, 0, 0, loc),
+			first:    time.Sunday,
+			expected: time.Date(2024, 3, 17, 0, 0, 0, 0, loc),
+		},
+		{
+			name:     "saturday with sunday start",
+			input:    time.Date(2024, 3, 16, 14, 0, 0, 0, loc),
+			first:    time.Sunday,
+			expected: time.Date(2024, 3, 10, 0, 0, 0, 0, loc),
+		},
+		{
+			name:     "sunday with monday start",
+			input:    time.Date(2024, 3, 17, 14, 0, 0, 0, loc),
+			first:    time.Monday,
+			expected: time.Date(2024, 3, 11, 0, 0, 0, 0, loc),
+		},
+		{
+			name:     "friday with saturday start",
+			input:    time.Date(2024, 3, 15, 14, 0, 0, 0, loc),
+			first:    time.Saturday,
+			expected: time.Date(2024, 3, 9, 0, 0, 0, 0, loc),
+		},
+		{
+			name:     "across month boundary",
+			input:    time.Date(2024, 3, 2, 14, 0, 0, 0, loc),
+			first:    time.Sunday,
+			expected: time.Date(2024, 2, 25, 0, 0, 0, 0, loc),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := StartOfWeekOn(tt.input, tt.first)
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, tt.first, result.Weekday())
+			assert.Equal(t, tt.expected.AddDate(0, 0, 7).Add(-time.Nanosecond), EndOfWeekOn(tt.input, tt.first))
+		})
+	}
+}
+
+func TestBoundaries_Idempotent(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	input := time.Date(2024, 2, 14, 9, 30, 0, 0, loc)
+
+	funcs := map[string]func(time.Time) time.Time{
+		"StartOfWeek":    StartOfWeek,
+		"EndOfWeek":      EndOfWeek,
+		"StartOfMonth":   StartOfMonth,
+		"EndOfMonth":     EndOfMonth,
+		"StartOfQuarter": StartOfQuarter,
+		"EndOfQuarter":   EndOfQuarter,
+		"StartOfYear":    StartOfYear,
+		"EndOfYear":      EndOfYear,
+		"StartOfWeekOn(Sunday)": func(t time.Time) time.Time {
+			return StartOfWeekOn(t, time.Sunday)
+		},
+		"EndOfWeekOn(Sunday)": func(t time.Time) time.Time {
+			return EndOfWeekOn(t, time.Sunday)
+		},
+	}
+
+	for name, fn := range funcs {
+		t.Run(name, func(t *testing.T) {
+			once := fn(input)
+			assert.Equal(t, once, fn(once))
+			assert.Same(t, loc, once.Location())
+		})
+	}
+}
+
 func TestStartOfMonth(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -225,6 +401,62 @@ func TestEndOfMonth(t *testing.T) {
 	}
 }
 
+func TestQuarterBoundaries(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*60*60)
+
+	tests := []struct {
+		name    string
+		input   time.Time
+		quarter int
+		start   time.Time
+		end     time.Time
+	}{
+		{
+			name:    "first day of q1",
+			input:   time.Date(2024, 1, 1, 0, 0, 0, 0, loc),
+			quarter: 1,
+			start:   time.Date(2024, 1, 1, 0, 0, 0, 0, loc),
+			end:     time.Date(2024, 3, 31, 23, 59, 59, 999999999, loc),
+		},
+		{
+			name:    "last nanosecond of q1",
+			input:   time.Date(2024, 3, 31, 23, 59, 59, 999999999, loc),
+			quarter: 1,
+			start:   time.Date(2024, 1, 1, 0, 0, 0, 0, loc),
+			end:     time.Date(2024, 3, 31, 23, 59, 59, 999999999, loc),
+		},
+		{
+			name:    "q2",
+			input:   time.Date(2024, 4, 1, 0, 0, 0, 0, loc),
+			quarter: 2,
+			start:   time.Date(2024, 4, 1, 0, 0, 0, 0, loc),
+			end:     time.Date(2024, 6, 30, 23, 59, 59, 999999999, loc),
+		},
+		{
+			name:    "q3",
+			input:   time.Date(2024, 8, 31, 12, 0, 0, 0, loc),
+			quarter: 3,
+			start:   time.Date(2024, 7, 1, 0, 0, 0, 0, loc),
+			end:     time.Date(2024, 9, 30, 23, 59, 59, 999999999, loc),
+		},
+		{
+			name:    "december is q4",
+			input:   time.Date(2024, 12, 31, 23, 0, 0, 0, loc),
+			quarter: 4,
+			start:   time.Date(2024, 10, 1, 0, 0, 0, 0, loc),
+			end:     time.Date(2024, 12, 31, 23, 59, 59, 999999999, loc),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.quarter, Quarter(tt.input))
+			assert.Equal(t, tt.start, StartOfQuarter(tt.input))
+			assert.Equal(t, tt.end, EndOfQuarter(tt.input))
+		})
+	}
+}
+
 func TestStartOfYear(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,28 @@
+package time
+
+import "time"
+
+// IsSameDay reports whether a and b fall on the same calendar day as seen in
+// a's location; b is converted to a's location first. Use IsSameDayIn to pick
+// the zone explicitly.
+func IsSameDay(a, b time.Time) bool {
+	b = b.In(a.Location())
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
+
+// IsSameDayIn reports whether a and b fall on the same calendar day in loc.
+func IsSameDayIn(a, b time.Time, loc *time.Location) bool {
+	return IsSameDay(a.In(loc), b)
+}
+
+// IsSameMonth reports whether a and b fall in the same month of the same year
+// in a's location.
+func IsSameMonth(a, b time.Time) bool {
+	b = b.In(a.Location())
+	return a.Year() == b.Year() && a.Month() == b.Month()
+}
+
+// IsSameYear reports whether a and b fall in the same year in a's location.
+func IsSameYear(a, b time.Time) bool {
+	return a.Year() == b.In(a.Location()).Year()
+}
@@ -0,0 +1,89 @@
+package time
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSameDay(t *testing.T) {
+	shanghai := time.FixedZone("UTC+8", 8*60*60)
+
+	tests := []struct {
+		name     string
+		a        time.Time
+		b        time.Time
+		expected bool
+	}{
+		{
+			name:     "same day",
+			a:        time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+			b:        time.Date(2024, 3, 15, 23, 59, 59, 0, time.UTC),
+			expected: true,
+		},
+		{
+			name:     "next day",
+			a:        time.Date(2024, 3, 15, 23, 59, 59, 0, time.UTC),
+			b:        time.Date(2024, 3, 16, 0, 0, 0, 0, time.UTC),
+			expected: false,
+		},
+		{
+			name:     "same day number in another year",
+			a:        time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC),
+			b:        time.Date(2023, 3, 15, 12, 0, 0, 0, time.UTC),
+			expected: false,
+		},
+		{
+			// 2024-03-15 20:00 UTC is 2024-03-16 04:00 in UTC+8.
+			name:     "same instant judged in a's zone",
+			a:        time.Date(2024, 3, 15, 20, 0, 0, 0, time.UTC),
+			b:        time.Date(2024, 3, 16, 4, 0, 0, 0, shanghai),
+			expected: true,
+		},
+		{
+			name:     "same instant straddling midnight from the other side",
+			a:        time.Date(2024, 3, 16, 4, 0, 0, 0, shanghai),
+			b:        time.Date(2024, 3, 15, 20, 0, 0, 0, time.UTC),
+			expected: true,
+		},
+		{
+			// 2024-03-16 20:00 UTC is already 2024-03-17 in UTC+8.
+			name:     "same wall date but next day in a's zone",
+			a:        time.Date(2024, 3, 16, 4, 0, 0, 0, shanghai),
+			b:        time.Date(2024, 3, 16, 20, 0, 0, 0, time.UTC),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsSameDay(tt.a, tt.b))
+		})
+	}
+}
+
+func TestIsSameDayIn(t *testing.T) {
+	shanghai := time.FixedZone("UTC+8", 8*60*60)
+	a := time.Date(2024, 3, 15, 14, 0, 0, 0, time.UTC) // 22:00 in UTC+8
+	b := time.Date(2024, 3, 15, 17, 0, 0, 0, time.UTC) // 01:00 next day in UTC+8
+
+	assert.True(t, IsSameDayIn(a, b, time.UTC))
+	assert.False(t, IsSameDayIn(a, b, shanghai))
+	assert.True(t, IsSameDay(a, b))
+}
+
+func TestIsSameMonthAndYear(t *testing.T) {
+	shanghai := time.FixedZone("UTC+8", 8*60*60)
+	endOfMarch := time.Date(2024, 3, 31, 20, 0, 0, 0, time.UTC)
+
+	assert.True(t, IsSameMonth(endOfMarch, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, IsSameMonth(endOfMarch, time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC)))
+	// The same instant is already April in UTC+8.
+	assert.True(t, IsSameMonth(endOfMarch, endOfMarch.In(shanghai)))
+	assert.False(t, IsSameMonth(endOfMarch.In(shanghai), time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC)))
+
+	endOfYear := time.Date(2024, 12, 31, 20, 0, 0, 0, time.UTC)
+	assert.True(t, IsSameYear(endOfYear, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, IsSameYear(endOfYear.In(shanghai), time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)))
+}
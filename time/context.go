@@ -0,0 +1,15 @@
+package time
+
+import (
+	"context"
+	"time"
+)
+
+// ContextUntilEndOfDay returns a context whose deadline is EndOfDay(now). A nil
+// context is treated as context.Background.
+func ContextUntilEndOfDay(ctx context.Context, now time.Time) (context.Context, context.CancelFunc) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithDeadline(ctx, EndOfDay(now))
+}
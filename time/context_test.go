@@ -0,0 +1,32 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextUntilEndOfDay(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*60*60)
+	now := time.Now().In(loc)
+
+	ctx, cancel := ContextUntilEndOfDay(context.Background(), now)
+	defer cancel()
+
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	assert.True(t, deadline.Equal(EndOfDay(now)))
+	assert.NoError(t, ctx.Err())
+}
+
+func TestContextUntilEndOfDay_PastDeadline(t *testing.T) {
+	yesterday := time.Now().AddDate(0, 0, -1)
+
+	ctx, cancel := ContextUntilEndOfDay(context.Background(), yesterday)
+	defer cancel()
+
+	assert.ErrorIs(t, ctx.Err(), context.DeadlineExceeded)
+}
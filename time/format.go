@@ -0,0 +1,78 @@
+package time
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrUnknownLayout is returned by ParseFlexible when no supported layout
+// matches the input.
+var ErrUnknownLayout = errors.New("no known layout matches")
+
+// flexibleLayouts are tried in order by ParseFlexible. Day-first and
+// month-first forms such as "03/04/2024" are deliberately absent because they
+// cannot be told apart.
+var flexibleLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	time.DateTime,
+	"2006-01-02 15:04",
+	time.DateOnly,
+	"2006/01/02 15:04:05",
+	"2006/01/02 15:04",
+	"2006/01/02",
+	time.RFC1123Z,
+	time.RFC1123,
+}
+
+// ParseDate parses s as "2006-01-02" at midnight in loc. A nil loc is treated
+// as time.UTC.
+func ParseDate(s string, loc *time.Location) (time.Time, error) {
+	return time.ParseInLocation(time.DateOnly, s, orUTC(loc))
+}
+
+// ParseDateTime parses s as "2006-01-02 15:04:05" in loc. A nil loc is treated
+// as time.UTC.
+func ParseDateTime(s string, loc *time.Location) (time.Time, error) {
+	return time.ParseInLocation(time.DateTime, s, orUTC(loc))
+}
+
+// FormatDate formats t as "2006-01-02" in t's location.
+func FormatDate(t time.Time) string {
+	return t.Format(time.DateOnly)
+}
+
+// FormatDateTime formats t as "2006-01-02 15:04:05" in t's location.
+func FormatDateTime(t time.Time) string {
+	return t.Format(time.DateTime)
+}
+
+// ParseFlexible parses s with the first matching layout among RFC 3339,
+// "2006-01-02 15:04:05", "2006-01-02", "2006/01/02" and a few close variants.
+// Inputs without a zone are read as UTC; use ParseFlexibleInLocation to pick
+// another location.
+func ParseFlexible(s string) (time.Time, error) {
+	return ParseFlexibleInLocation(s, time.UTC)
+}
+
+// ParseFlexibleInLocation is like ParseFlexible but reads inputs without a
+// zone in loc. A nil loc is treated as time.UTC.
+func ParseFlexibleInLocation(s string, loc *time.Location) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	loc = orUTC(loc)
+	for _, layout := range flexibleLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: %q", ErrUnknownLayout, s)
+}
+
+func orUTC(loc *time.Location) *time.Location {
+	if loc == nil {
+		return time.UTC
+	}
+	return loc
+}
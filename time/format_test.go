@@ -0,0 +1,84 @@
+package time
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDateAndDateTime(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*60*60)
+
+	d, err := ParseDate("2024-03-15", loc)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 15, 0, 0, 0, 0, loc), d)
+	assert.Equal(t, "2024-03-15", FormatDate(d))
+
+	dt, err := ParseDateTime("2024-03-15 09:30:00", loc)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 15, 9, 30, 0, 0, loc), dt)
+	assert.Equal(t, "2024-03-15 09:30:00", FormatDateTime(dt))
+
+	utc, err := ParseDate("2024-03-15", nil)
+	require.NoError(t, err)
+	assert.Equal(t, time.UTC, utc.Location())
+
+	_, err = ParseDate("2024-03-15 09:30:00", loc)
+	assert.Error(t, err)
+	_, err = ParseDateTime("2024-03-15", loc)
+	assert.Error(t, err)
+}
+
+func TestParseFlexible(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Time
+	}{
+		{"rfc3339", "2024-03-15T09:30:00+08:00", time.Date(2024, 3, 15, 1, 30, 0, 0, time.UTC)},
+		{"rfc3339 nano", "2024-03-15T09:30:00.5Z", time.Date(2024, 3, 15, 9, 30, 0, 500000000, time.UTC)},
+		{"iso without zone", "2024-03-15T09:30:00", time.Date(2024, 3, 15, 9, 30, 0, 0, time.UTC)},
+		{"date time", "2024-03-15 09:30:00", time.Date(2024, 3, 15, 9, 30, 0, 0, time.UTC)},
+		{"date time without seconds", "2024-03-15 09:30", time.Date(2024, 3, 15, 9, 30, 0, 0, time.UTC)},
+		{"date", " 2024-03-04 ", time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{"slashed date", "2024/03/04", time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{"rfc1123z", "Fri, 15 Mar 2024 09:30:00 +0000", time.Date(2024, 3, 15, 9, 30, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFlexible(tt.input)
+			require.NoError(t, err)
+			assert.True(t, tt.expected.Equal(got), "got %v", got)
+		})
+	}
+}
+
+func TestParseFlexible_Rejects(t *testing.T) {
+	for _, input := range []string{
+		"",
+		"not a date",
+		"03/04/2024", // month-first or day-first; refuse to guess
+		"2024-02-30",
+		"2024-03-15T25:00:00Z",
+	} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseFlexible(input)
+			assert.ErrorIs(t, err, ErrUnknownLayout)
+		})
+	}
+}
+
+func TestParseFlexibleInLocation(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*60*60)
+
+	got, err := ParseFlexibleInLocation("2024-03-15 09:30:00", loc)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 15, 9, 30, 0, 0, loc), got)
+
+	// An explicit offset in the input wins over loc.
+	got, err = ParseFlexibleInLocation("2024-03-15T09:30:00Z", loc)
+	require.NoError(t, err)
+	assert.True(t, time.Date(2024, 3, 15, 9, 30, 0, 0, time.UTC).Equal(got))
+}
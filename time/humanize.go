@@ -0,0 +1,74 @@
+package time
+
+import (
+	"strconv"
+	"time"
+)
+
+const day = 24 * time.Hour
+
+var humanizeUnits = []struct {
+	size  time.Duration
+	short string
+	long  string
+}{
+	{day, "d", "day"},
+	{time.Hour, "h", "hour"},
+	{time.Minute, "m", "minute"},
+	{time.Second, "s", "second"},
+}
+
+// Humanize formats d compactly using days, hours, minutes, and seconds, for
+// example "5d", "2h3m", or "1m30s". It keeps the largest unit plus the next
+// smaller one when that is non-zero, and truncates everything below, so
+// 2h0m59s becomes "2h" and durations under a second become "0s". Negative
+// durations get a leading "-".
+func Humanize(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+
+	for i, unit := range humanizeUnits {
+		if d < unit.size {
+			continue
+		}
+		s := sign + strconv.FormatInt(int64(d/unit.size), 10) + unit.short
+		if i+1 < len(humanizeUnits) {
+			next := humanizeUnits[i+1]
+			if n := d % unit.size / next.size; n > 0 {
+				s += strconv.FormatInt(int64(n), 10) + next.short
+			}
+		}
+		return s
+	}
+	return "0s"
+}
+
+// RelativeTo describes t relative to now in its largest whole unit, such as
+// "3 days ago" or "in 2 hours". Smaller units are truncated, so 90 minutes ago
+// is "1 hour ago". Differences under a second are "just now".
+func RelativeTo(t, now time.Time) string {
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+
+	for _, unit := range humanizeUnits {
+		if d < unit.size {
+			continue
+		}
+		n := int64(d / unit.size)
+		s := strconv.FormatInt(n, 10) + " " + unit.long
+		if n != 1 {
+			s += "s"
+		}
+		if future {
+			return "in " + s
+		}
+		return s + " ago"
+	}
+	return "just now"
+}
@@ -0,0 +1,59 @@
+package time
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHumanize(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    time.Duration
+		expected string
+	}{
+		{"zero", 0, "0s"},
+		{"sub-second", 999 * time.Millisecond, "0s"},
+		{"seconds", 45 * time.Second, "45s"},
+		{"minutes and seconds", 90 * time.Second, "1m30s"},
+		{"whole minutes", 3 * time.Minute, "3m"},
+		{"hours and minutes", 2*time.Hour + 3*time.Minute + 59*time.Second, "2h3m"},
+		{"zero middle unit", 2*time.Hour + 59*time.Second, "2h"},
+		{"days", 5 * day, "5d"},
+		{"days and hours", 26 * time.Hour, "1d2h"},
+		{"negative", -(90 * time.Second), "-1m30s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Humanize(tt.input))
+		})
+	}
+}
+
+func TestRelativeTo(t *testing.T) {
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    time.Time
+		expected string
+	}{
+		{"same instant", now, "just now"},
+		{"sub-second", now.Add(-500 * time.Millisecond), "just now"},
+		{"one second", now.Add(-time.Second), "1 second ago"},
+		{"seconds", now.Add(-30 * time.Second), "30 seconds ago"},
+		{"minutes", now.Add(-5 * time.Minute), "5 minutes ago"},
+		{"truncates", now.Add(-90 * time.Minute), "1 hour ago"},
+		{"days", now.AddDate(0, 0, -3), "3 days ago"},
+		{"future hours", now.Add(2 * time.Hour), "in 2 hours"},
+		{"future day", now.Add(30 * time.Hour), "in 1 day"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, RelativeTo(tt.input, now))
+		})
+	}
+}
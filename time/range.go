@@ -0,0 +1,53 @@
+package time
+
+import (
+	"iter"
+	"time"
+)
+
+// DaysBetween returns the start of every calendar day from start's day to
+// end's day inclusive, in start's location. It returns an empty slice when
+// end falls on an earlier day than start.
+func DaysBetween(start, end time.Time) []time.Time {
+	days := []time.Time{}
+	for d := range IterDays(start, end) {
+		days = append(days, d)
+	}
+	return days
+}
+
+// IterDays lazily yields what DaysBetween returns. Days advance by calendar
+// date rather than by 24 hours, so DST days of 23 or 25 hours are handled.
+func IterDays(start, end time.Time) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		last := StartOfDay(end.In(start.Location()))
+		for d := StartOfDay(start); !d.After(last); d = StartOfDay(d.AddDate(0, 0, 1)) {
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// QuartersBetween returns the start of every calendar quarter from start's
+// quarter to end's quarter inclusive, in start's location. It returns an empty
+// slice when end falls in an earlier quarter than start.
+func QuartersBetween(start, end time.Time) []time.Time {
+	quarters := []time.Time{}
+	for q := range IterQuarters(start, end) {
+		quarters = append(quarters, q)
+	}
+	return quarters
+}
+
+// IterQuarters lazily yields what QuartersBetween returns.
+func IterQuarters(start, end time.Time) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		last := StartOfQuarter(end.In(start.Location()))
+		for q := StartOfQuarter(start); !q.After(last); q = StartOfQuarter(q.AddDate(0, 3, 0)) {
+			if !yield(q) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package time
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDaysBetween(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*60*60)
+
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		expected []time.Time
+	}{
+		{
+			name:  "inclusive range",
+			start: time.Date(2024, 2, 28, 15, 0, 0, 0, loc),
+			end:   time.Date(2024, 3, 1, 9, 0, 0, 0, loc),
+			expected: []time.Time{
+				time.Date(2024, 2, 28, 0, 0, 0, 0, loc),
+				time.Date(2024, 2, 29, 0, 0, 0, 0, loc),
+				time.Date(2024, 3, 1, 0, 0, 0, 0, loc),
+			},
+		},
+		{
+			name:     "same day",
+			start:    time.Date(2024, 3, 1, 9, 0, 0, 0, loc),
+			end:      time.Date(2024, 3, 1, 8, 0, 0, 0, loc),
+			expected: []time.Time{time.Date(2024, 3, 1, 0, 0, 0, 0, loc)},
+		},
+		{
+			name:     "start after end",
+			start:    time.Date(2024, 3, 2, 0, 0, 0, 0, loc),
+			end:      time.Date(2024, 3, 1, 23, 0, 0, 0, loc),
+			expected: []time.Time{},
+		},
+		{
+			name:  "end in another zone",
+			start: time.Date(2024, 3, 1, 9, 0, 0, 0, loc),
+			// 2024-03-02 20:00 UTC is 2024-03-03 04:00 in UTC+8.
+			end: time.Date(2024, 3, 2, 20, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2024, 3, 1, 0, 0, 0, 0, loc),
+				time.Date(2024, 3, 2, 0, 0, 0, 0, loc),
+				time.Date(2024, 3, 3, 0, 0, 0, 0, loc),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DaysBetween(tt.start, tt.end))
+		})
+	}
+}
+
+func TestDaysBetween_SpringForward(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("load location: %v", err)
+	}
+
+	// 2024-03-10 is only 23 hours long in New York.
+	days := DaysBetween(time.Date(2024, 3, 9, 12, 0, 0, 0, ny), time.Date(2024, 3, 12, 0, 0, 0, 0, ny))
+	require.Len(t, days, 4)
+	for i, d := range days {
+		assert.Equal(t, 9+i, d.Day())
+		assert.Zero(t, d.Hour())
+		assert.Same(t, ny, d.Location())
+	}
+	assert.Equal(t, 23*time.Hour, days[2].Sub(days[1]))
+}
+
+func TestIterDays_StopsEarly(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var got []time.Time
+	for d := range IterDays(start, start.AddDate(1, 0, 0)) {
+		got = append(got, d)
+		if len(got) == 3 {
+			break
+		}
+	}
+	assert.Equal(t, []time.Time{start, start.AddDate(0, 0, 1), start.AddDate(0, 0, 2)}, got)
+}
+
+func TestQuartersBetween(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*60*60)
+
+	got := QuartersBetween(time.Date(2023, 11, 15, 0, 0, 0, 0, loc), time.Date(2024, 4, 1, 0, 0, 0, 0, loc))
+	assert.Equal(t, []time.Time{
+		time.Date(2023, 10, 1, 0, 0, 0, 0, loc),
+		time.Date(2024, 1, 1, 0, 0, 0, 0, loc),
+		time.Date(2024, 4, 1, 0, 0, 0, 0, loc),
+	}, got)
+
+	assert.Equal(t, []time.Time{time.Date(2024, 1, 1, 0, 0, 0, 0, loc)},
+		QuartersBetween(time.Date(2024, 3, 31, 0, 0, 0, 0, loc), time.Date(2024, 1, 1, 0, 0, 0, 0, loc)))
+	assert.Empty(t, QuartersBetween(time.Date(2024, 4, 1, 0, 0, 0, 0, loc), time.Date(2024, 3, 31, 0, 0, 0, 0, loc)))
+}
@@ -0,0 +1,73 @@
+package time
+
+import "time"
+
+// Stopwatch measures elapsed time and lap splits. It reads the monotonic clock
+// carried by time.Now, so Elapsed and Lap are unaffected by NTP adjustments or
+// manual changes to the wall clock. The zero value is stopped; call Start
+// before use. A Stopwatch is not safe for concurrent use.
+type Stopwatch struct {
+	start   time.Time
+	lastLap time.Time
+	laps    []time.Duration
+}
+
+// StartStopwatch returns a Stopwatch that is already running.
+func StartStopwatch() *Stopwatch {
+	s := &Stopwatch{}
+	s.Start()
+	return s
+}
+
+// Measure runs fn and returns how long it took.
+func Measure(fn func()) time.Duration {
+	s := StartStopwatch()
+	fn()
+	return s.Elapsed()
+}
+
+// Start starts the stopwatch from zero, discarding recorded laps.
+func (s *Stopwatch) Start() {
+	now := time.Now()
+	s.start = now
+	s.lastLap = now
+	s.laps = nil
+}
+
+// Reset stops the stopwatch and discards recorded laps.
+func (s *Stopwatch) Reset() {
+	*s = Stopwatch{}
+}
+
+// Running reports whether the stopwatch has been started.
+func (s *Stopwatch) Running() bool {
+	return !s.start.IsZero()
+}
+
+// Elapsed returns the time since Start, or zero if the stopwatch is not
+// running.
+func (s *Stopwatch) Elapsed() time.Duration {
+	if !s.Running() {
+		return 0
+	}
+	return time.Since(s.start)
+}
+
+// Lap records and returns the time since the previous lap, or since Start for
+// the first lap. It returns zero and records nothing if the stopwatch is not
+// running.
+func (s *Stopwatch) Lap() time.Duration {
+	if !s.Running() {
+		return 0
+	}
+	now := time.Now()
+	split := now.Sub(s.lastLap)
+	s.lastLap = now
+	s.laps = append(s.laps, split)
+	return split
+}
+
+// Laps returns a copy of the recorded lap splits in the order they were taken.
+func (s *Stopwatch) Laps() []time.Duration {
+	return append([]time.Duration(nil), s.laps...)
+}
@@ -0,0 +1,62 @@
+package time
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStopwatch_Elapsed(t *testing.T) {
+	var s Stopwatch
+	assert.False(t, s.Running())
+	assert.Zero(t, s.Elapsed())
+
+	s.Start()
+	assert.True(t, s.Running())
+	first := s.Elapsed()
+	time.Sleep(2 * time.Millisecond)
+	second := s.Elapsed()
+	assert.Greater(t, second, first)
+	assert.GreaterOrEqual(t, second, 2*time.Millisecond)
+
+	s.Reset()
+	assert.False(t, s.Running())
+	assert.Zero(t, s.Elapsed())
+	assert.Empty(t, s.Laps())
+}
+
+func TestStopwatch_Laps(t *testing.T) {
+	var s Stopwatch
+	assert.Zero(t, s.Lap())
+	assert.Empty(t, s.Laps())
+
+	s.Start()
+	time.Sleep(time.Millisecond)
+	first := s.Lap()
+	time.Sleep(3 * time.Millisecond)
+	second := s.Lap()
+
+	laps := s.Laps()
+	require.Equal(t, []time.Duration{first, second}, laps)
+	assert.GreaterOrEqual(t, first, time.Millisecond)
+	assert.GreaterOrEqual(t, second, 3*time.Millisecond)
+	assert.LessOrEqual(t, first+second, s.Elapsed())
+
+	laps[0] = 0
+	assert.Equal(t, first, s.Laps()[0], "Laps must return a copy")
+
+	s.Start()
+	assert.Empty(t, s.Laps())
+}
+
+func TestMeasure(t *testing.T) {
+	called := false
+	d := Measure(func() {
+		called = true
+		time.Sleep(time.Millisecond)
+	})
+	assert.True(t, called)
+	assert.GreaterOrEqual(t, d, time.Millisecond)
+}
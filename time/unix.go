@@ -0,0 +1,27 @@
+package time
+
+import "time"
+
+// FromUnixMilli returns the time ms milliseconds after the Unix epoch, in loc
+// rather than the local zone, so that day and week boundaries computed from it
+// follow loc. A nil loc is treated as time.UTC.
+func FromUnixMilli(ms int64, loc *time.Location) time.Time {
+	return time.UnixMilli(ms).In(orUTC(loc))
+}
+
+// ToUnixMilli returns t as milliseconds since the Unix epoch. The result does
+// not depend on t's location.
+func ToUnixMilli(t time.Time) int64 {
+	return t.UnixMilli()
+}
+
+// FromUnixMicro is like FromUnixMilli for microseconds.
+func FromUnixMicro(us int64, loc *time.Location) time.Time {
+	return time.UnixMicro(us).In(orUTC(loc))
+}
+
+// ToUnixMicro returns t as microseconds since the Unix epoch. The result does
+// not depend on t's location.
+func ToUnixMicro(t time.Time) int64 {
+	return t.UnixMicro()
+}
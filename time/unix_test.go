@@ -0,0 +1,45 @@
+package time
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromUnixMilli(t *testing.T) {
+	loc := time.FixedZone("UTC+8", 8*60*60)
+	// 2024-03-14T20:00:00.123Z, already March 15 in UTC+8.
+	const ms = 1710446400123
+
+	got := FromUnixMilli(ms, loc)
+	assert.Same(t, loc, got.Location())
+	assert.Equal(t, time.Date(2024, 3, 15, 4, 0, 0, 123_000_000, loc), got)
+	assert.Equal(t, time.Date(2024, 3, 15, 0, 0, 0, 0, loc), StartOfDay(got))
+	assert.Equal(t, int64(ms), ToUnixMilli(got))
+
+	utc := FromUnixMilli(ms, nil)
+	assert.Equal(t, time.UTC, utc.Location())
+	assert.True(t, utc.Equal(got))
+}
+
+func TestFromUnixMicro(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	const us = 1710446400123456
+
+	got := FromUnixMicro(us, loc)
+	assert.Same(t, loc, got.Location())
+	assert.Equal(t, time.Date(2024, 3, 14, 15, 0, 0, 123_456_000, loc), got)
+	assert.Equal(t, int64(us), ToUnixMicro(got))
+	assert.Equal(t, int64(us/1000), ToUnixMilli(got))
+
+	assert.Equal(t, time.UTC, FromUnixMicro(us, nil).Location())
+}
+
+func TestUnixRoundTrip(t *testing.T) {
+	loc := time.FixedZone("UTC+5:45", 5*60*60+45*60)
+	for _, ms := range []int64{0, -1, 1710446400123} {
+		assert.Equal(t, ms, ToUnixMilli(FromUnixMilli(ms, loc)))
+		assert.Equal(t, ms*1000, ToUnixMicro(FromUnixMicro(ms*1000, loc)))
+	}
+}
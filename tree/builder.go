@@ -4,6 +4,7 @@ import (
 	"cmp"
 	"fmt"
 	"slices"
+	"strings"
 	"sync"
 )
 
@@ -15,6 +16,11 @@ type item[T any, K comparable] struct {
 }
 
 // Builder incrementally builds a validated Tree.
+//
+// A Builder is safe for concurrent use. The tree is built lazily on the first
+// query after a change and cached until the next change, so concurrent
+// queries on a modified builder build it once. For read-heavy sharing, pass
+// the *Tree returned by Build instead: it has no mutators.
 type Builder[T any, K comparable] struct {
 	mu    sync.RWMutex
 	items []*item[T, K]
@@ -25,6 +31,7 @@ type Builder[T any, K comparable] struct {
 	parentFn  func(T) (K, bool)
 	sortFn    func(T) int
 	sortCmpFn func(T, T) int
+	sortDesc  bool
 
 	dirty  bool
 	cached *Tree[T, K]
@@ -109,6 +116,16 @@ func (b *Builder[T, K]) SortByFunc(fn func(T, T) int) *Builder[T, K] {
 	return b
 }
 
+// SortDescending reverses the sibling order produced by SortBy, SortByFunc, or
+// insertion order. Siblings that compare equal keep their insertion order.
+func (b *Builder[T, K]) SortDescending() *Builder[T, K] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.sortDesc = true
+	b.invalidate()
+	return b
+}
+
 func (b *Builder[T, K]) invalidate() {
 	b.dirty = true
 	b.cached = nil
@@ -119,6 +136,16 @@ func (b *Builder[T, K]) Build() (*Tree[T, K], error) {
 	return b.ensureTree()
 }
 
+// String renders the built tree as indented text. It returns a description of
+// the build error when the items do not form a valid tree.
+func (b *Builder[T, K]) String() string {
+	tree, err := b.ensureTree()
+	if err != nil {
+		return fmt.Sprintf("invalid tree: %v", err)
+	}
+	return tree.String()
+}
+
 // Clone returns a builder copy that can be mutated independently.
 func (b *Builder[T, K]) Clone() *Builder[T, K] {
 	b.mu.Lock()
@@ -141,6 +168,7 @@ func (b *Builder[T, K]) Clone() *Builder[T, K] {
 		parentFn:  b.parentFn,
 		sortFn:    b.sortFn,
 		sortCmpFn: b.sortCmpFn,
+		sortDesc:  b.sortDesc,
 		dirty:     true,
 	}
 }
@@ -154,6 +182,7 @@ func (b *Builder[T, K]) Statistics() (Stats, error) {
 }
 
 // Validate returns all validation errors it can collect without building a tree.
+// Each duplicated key is reported once regardless of how many items share it.
 func (b *Builder[T, K]) Validate() []error {
 	b.mu.RLock()
 	defer b.mu.RUnlock()
@@ -170,12 +199,16 @@ func (b *Builder[T, K]) Validate() []error {
 	parentKeys := make([]K, count)
 	hasParents := make([]bool, count)
 
+	reported := make(map[K]struct{})
 	for i, n := range b.items {
 		k := b.keyFn(n.data)
 		keys[i] = k
 
 		if _, ok := keyIndex[k]; ok {
-			errs = append(errs, fmt.Errorf("%w: %v", ErrDuplicateKey, k))
+			if _, seen := reported[k]; !seen {
+				reported[k] = struct{}{}
+				errs = append(errs, fmt.Errorf("%w: %v", ErrDuplicateKey, k))
+			}
 		}
 		keyIndex[k] = i
 
@@ -184,12 +217,8 @@ func (b *Builder[T, K]) Validate() []error {
 		hasParents[i] = has
 	}
 
-	for i, k := range keys {
-		if hasParents[i] {
-			if _, ok := keyIndex[parentKeys[i]]; !ok {
-				errs = append(errs, fmt.Errorf("%w: %v", ErrOrphanedNode, k))
-			}
-		}
+	for _, k := range findOrphans(keys, parentKeys, hasParents, keyIndex) {
+		errs = append(errs, fmt.Errorf("%w: %v", ErrOrphanedNode, k))
 	}
 
 	if cycleKey, found := detectCycle(keys, parentKeys, hasParents, keyIndex); found {
@@ -199,6 +228,33 @@ func (b *Builder[T, K]) Validate() []error {
 	return errs
 }
 
+// Orphans returns, in insertion order, the keys of items whose declared parent
+// key does not exist. Roots without a parent are not orphans.
+func (b *Builder[T, K]) Orphans() ([]K, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.keyFn == nil {
+		return nil, ErrKeyNotSet
+	}
+
+	count := len(b.items)
+	keys := make([]K, count)
+	keyIndex := make(map[K]int, count)
+	for i, n := range b.items {
+		keys[i] = b.keyFn(n.data)
+		keyIndex[keys[i]] = i
+	}
+
+	parentKeys := make([]K, count)
+	hasParents := make([]bool, count)
+	for i, n := range b.items {
+		parentKeys[i], hasParents[i] = b.resolveParent(n, keys[i])
+	}
+
+	return findOrphans(keys, parentKeys, hasParents, keyIndex), nil
+}
+
 func (b *Builder[T, K]) Filter(fn func(T) bool) *Builder[T, K] {
 	b.mu.Lock()
 	defer b.mu.Unlock()
@@ -218,11 +274,44 @@ func (b *Builder[T, K]) Filter(fn func(T) bool) *Builder[T, K] {
 		parentFn:  b.parentFn,
 		sortFn:    b.sortFn,
 		sortCmpFn: b.sortCmpFn,
+		sortDesc:  b.sortDesc,
 		dirty:     true,
 	}
 	return clone
 }
 
+// Prune returns a builder without items deeper than the 1-based maxDepth.
+// Items at maxDepth become leaves. The original builder is left unchanged.
+func (b *Builder[T, K]) Prune(maxDepth int) (*Builder[T, K], error) {
+	tree, err := b.ensureTree()
+	if err != nil {
+		return nil, err
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	var pruned []*item[T, K]
+	for _, n := range b.items {
+		node, ok := tree.cache[b.keyFn(n.data)]
+		if ok && node.Level <= maxDepth {
+			cp := *n
+			pruned = append(pruned, &cp)
+		}
+	}
+
+	return &Builder[T, K]{
+		items:     pruned,
+		insertCtr: b.insertCtr,
+		keyFn:     b.keyFn,
+		parentFn:  b.parentFn,
+		sortFn:    b.sortFn,
+		sortCmpFn: b.sortCmpFn,
+		sortDesc:  b.sortDesc,
+		dirty:     true,
+	}, nil
+}
+
 func (b *Builder[T, K]) Map(fn func(T) T, keyFn func(T) K) *Builder[T, K] {
 	b.mu.Lock()
 	defer b.mu.Unlock()
@@ -244,6 +333,7 @@ func (b *Builder[T, K]) Map(fn func(T) T, keyFn func(T) K) *Builder[T, K] {
 		parentFn:  b.parentFn,
 		sortFn:    b.sortFn,
 		sortCmpFn: b.sortCmpFn,
+		sortDesc:  b.sortDesc,
 		dirty:     true,
 	}
 }
@@ -270,6 +360,16 @@ func (b *Builder[T, K]) Find(fn func(T) bool) (*Node[T], error) {
 	return nil, fmt.Errorf("item not found")
 }
 
+// FindNodes builds the tree and returns copies of all nodes matching fn in
+// depth-first pre-order. Unlike Find, fn can inspect Children and Level.
+func (b *Builder[T, K]) FindNodes(fn func(*Node[T]) bool) ([]*Node[T], error) {
+	tree, err := b.ensureTree()
+	if err != nil {
+		return nil, err
+	}
+	return tree.FindNodes(fn), nil
+}
+
 // ContainsKey reports whether key exists. It returns ErrKeyNotSet without KeyBy.
 func (b *Builder[T, K]) ContainsKey(key K) (bool, error) {
 	b.mu.RLock()
@@ -404,6 +504,107 @@ func (b *Builder[T, K]) IsDescendant(ancestor, key K) (bool, error) {
 	}
 }
 
+// CountDescendants builds the tree and returns the number of nodes below key.
+// An unknown key yields 0.
+func (b *Builder[T, K]) CountDescendants(key K) (int, error) {
+	tree, err := b.ensureTree()
+	if err != nil {
+		return 0, err
+	}
+	return tree.CountDescendants(key), nil
+}
+
+// IsLeaf builds the tree and reports whether key exists and has no children.
+func (b *Builder[T, K]) IsLeaf(key K) (bool, error) {
+	tree, err := b.ensureTree()
+	if err != nil {
+		return false, err
+	}
+	return tree.IsLeaf(key), nil
+}
+
+// Get returns a deep copy of key's node including all of its descendants.
+func (b *Builder[T, K]) Get(key K) (*Node[T], error) {
+	tree, err := b.ensureTree()
+	if err != nil {
+		return nil, err
+	}
+
+	node, ok := tree.Get(key)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrKeyNotFound, key)
+	}
+	return node, nil
+}
+
+// Ancestors returns copies of key's ancestors from parent to root. A root
+// key yields an empty slice.
+func (b *Builder[T, K]) Ancestors(key K) ([]*Node[T], error) {
+	tree, err := b.ensureTree()
+	if err != nil {
+		return nil, err
+	}
+
+	if !tree.ContainsKey(key) {
+		return nil, fmt.Errorf("%w: %v", ErrKeyNotFound, key)
+	}
+	ancestors, _ := tree.Ancestors(key)
+	if ancestors == nil {
+		ancestors = []*Node[T]{}
+	}
+	return ancestors, nil
+}
+
+// PathTo returns copies of nodes from a root to key.
+func (b *Builder[T, K]) PathTo(key K) ([]*Node[T], error) {
+	tree, err := b.ensureTree()
+	if err != nil {
+		return nil, err
+	}
+
+	path, ok := tree.PathTo(key)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrKeyNotFound, key)
+	}
+	return path, nil
+}
+
+// NodesAtDepth returns copies of all nodes at the 1-based depth.
+func (b *Builder[T, K]) NodesAtDepth(depth int) ([]*Node[T], error) {
+	tree, err := b.ensureTree()
+	if err != nil {
+		return nil, err
+	}
+	return tree.NodesAtDepth(depth), nil
+}
+
+// LevelOrder builds the tree and returns copies of its nodes grouped by
+// depth, roots first. See Tree.LevelOrder.
+func (b *Builder[T, K]) LevelOrder() ([][]*Node[T], error) {
+	tree, err := b.ensureTree()
+	if err != nil {
+		return nil, err
+	}
+	return tree.LevelOrder(), nil
+}
+
+// Walk builds the tree and visits nodes in depth-first pre-order until fn
+// returns false. The depth passed to fn is 1-based.
+func (b *Builder[T, K]) Walk(fn func(n *Node[T], depth int) bool) error {
+	tree, err := b.ensureTree()
+	if err != nil {
+		return err
+	}
+	if fn == nil {
+		return nil
+	}
+
+	tree.Walk(func(n *Node[T], _ *Node[T]) bool {
+		return fn(n, n.Level)
+	})
+	return nil
+}
+
 // RemoveItem removes key and all of its descendants.
 func (b *Builder[T, K]) RemoveItem(key K) error {
 	b.mu.Lock()
@@ -452,6 +653,9 @@ func (b *Builder[T, K]) RemoveItem(key K) error {
 	return nil
 }
 
+// MoveItem makes newParent the parent of key, taking key's subtree along. It
+// returns ErrInvalidMove when key is newParent and ErrCycle when newParent is
+// one of key's descendants; a rejected move leaves the builder unchanged.
 func (b *Builder[T, K]) MoveItem(key, newParent K) error {
 	b.mu.Lock()
 	defer b.mu.Unlock()
@@ -640,9 +844,14 @@ func (b *Builder[T, K]) buildTree() (*Tree[T, K], error) {
 	}
 
 	if b.sortCmpFn != nil {
-		sortForestWithCmp(roots, b.sortCmpFn)
+		cmpFn := b.sortCmpFn
+		if b.sortDesc {
+			asc := cmpFn
+			cmpFn = func(x, y T) int { return asc(y, x) }
+		}
+		sortForestWithCmp(roots, cmpFn)
 	} else {
-		sortForest(roots, nodeSort)
+		sortForest(roots, nodeSort, b.sortDesc)
 	}
 	assignLevels(roots, 1)
 
@@ -669,12 +878,12 @@ func validateTree[K comparable](
 	hasParents []bool,
 	keyIndex map[K]int,
 ) error {
-	for i, k := range keys {
-		if hasParents[i] {
-			if _, ok := keyIndex[parentKeys[i]]; !ok {
-				return fmt.Errorf("%w: %v", ErrOrphanedNode, k)
-			}
+	if orphans := findOrphans(keys, parentKeys, hasParents, keyIndex); len(orphans) > 0 {
+		parts := make([]string, len(orphans))
+		for i, k := range orphans {
+			parts[i] = fmt.Sprint(k)
 		}
+		return fmt.Errorf("%w: %s", ErrOrphanedNode, strings.Join(parts, ", "))
 	}
 
 	if cycleKey, found := detectCycle(keys, parentKeys, hasParents, keyIndex); found {
@@ -684,6 +893,25 @@ func validateTree[K comparable](
 	return nil
 }
 
+// findOrphans returns, in item order, the keys whose declared parent does not
+// exist.
+func findOrphans[K comparable](
+	keys []K,
+	parentKeys []K,
+	hasParents []bool,
+	keyIndex map[K]int,
+) []K {
+	var orphans []K
+	for i, k := range keys {
+		if hasParents[i] {
+			if _, ok := keyIndex[parentKeys[i]]; !ok {
+				orphans = append(orphans, k)
+			}
+		}
+	}
+	return orphans
+}
+
 // detectCycle reports whether keys/parentKeys/hasParents/keyIndex describe a
 // cycle. The returned key is the first node that closes the loop.
 func detectCycle[K comparable](
@@ -732,7 +960,7 @@ func detectCycle[K comparable](
 	return zero, false
 }
 
-func sortForest[T any](roots []*Node[T], sortVals map[*Node[T]]int) {
+func sortForest[T any](roots []*Node[T], sortVals map[*Node[T]]int, desc bool) {
 	type frame struct{ nodes []*Node[T] }
 	stack := []frame{{roots}}
 
@@ -742,6 +970,9 @@ func sortForest[T any](roots []*Node[T], sortVals map[*Node[T]]int) {
 
 		if len(f.nodes) > 1 {
 			slices.SortStableFunc(f.nodes, func(a, b *Node[T]) int {
+				if desc {
+					return cmp.Compare(sortVals[b], sortVals[a])
+				}
 				return cmp.Compare(sortVals[a], sortVals[b])
 			})
 		}
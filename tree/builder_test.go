@@ -1,6 +1,8 @@
 package tree
 
 import (
+	"cmp"
+	"errors"
 	"strings"
 	"sync"
 	"testing"
@@ -400,6 +402,27 @@ func TestBuilder_MoveItem(t *testing.T) {
 	assert.Equal(t, 3, children[0].Item.ID)
 }
 
+func TestBuilder_MoveItem_UnderDescendantLeavesTreeUnchanged(t *testing.T) {
+	b := NewBuilder[TestItem, int]()
+	b.KeyBy(keyFn).ParentBy(parentFn).WithItems([]TestItem{
+		{ID: 1, Name: "Root"},
+		{ID: 2, Name: "Child", ParentID: 1},
+		{ID: 3, Name: "Grandchild", ParentID: 2},
+		{ID: 4, Name: "Sibling", ParentID: 1},
+	})
+	before := b.String()
+
+	assert.ErrorIs(t, b.MoveItem(1, 3), ErrCycle)
+	assert.ErrorIs(t, b.MoveItem(2, 3), ErrCycle)
+
+	assert.Empty(t, b.Validate())
+	assert.Equal(t, before, b.String())
+	ancestors, err := b.Ancestors(3)
+	require.NoError(t, err)
+	require.Len(t, ancestors, 2)
+	assert.Equal(t, 2, ancestors[0].Item.ID)
+}
+
 func TestBuilder_MoveItem_Errors(t *testing.T) {
 	b := NewBuilder[TestItem, int]().KeyBy(keyFn).ParentBy(parentFn)
 	b.WithItems([]TestItem{{ID: 1, Name: "Root", ParentID: 1}})
@@ -534,6 +557,56 @@ func TestBuilder_ConcurrentBuildDuringAdd(t *testing.T) {
 	assert.Equal(t, 50, tree.Len())
 }
 
+func TestBuilder_ConcurrentReadsAfterChange(t *testing.T) {
+	b := NewBuilder[TestItem, int]().KeyBy(keyFn).ParentBy(parentFn)
+	for i := 1; i <= 200; i++ {
+		b.AddItem(TestItem{ID: i, ParentID: i / 2})
+	}
+
+	want, err := b.Clone().Statistics()
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, 100)
+	for range 20 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stats, err := b.Statistics()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			assert.Equal(t, want, stats)
+
+			levels, err := b.LevelOrder()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			assert.Len(t, levels, want.MaxDepth)
+
+			tree, err := b.Build()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			count := 0
+			tree.Walk(func(*Node[TestItem], *Node[TestItem]) bool {
+				count++
+				return true
+			})
+			assert.Equal(t, 200, count)
+		}()
+	}
+	wg.Wait()
+	close(errCh)
+
+	for err := range errCh {
+		require.NoError(t, err)
+	}
+}
+
 func TestBuilder_SortByFunc(t *testing.T) {
 	b := NewBuilder[TestItem, int]()
 	b.KeyBy(keyFn).ParentBy(parentFn).SortByFunc(func(a, b TestItem) int {
@@ -569,3 +642,347 @@ func TestBuilder_Subtree(t *testing.T) {
 	_, err = b.Subtree(999)
 	assert.Error(t, err)
 }
+
+func TestBuilder_PathTo(t *testing.T) {
+	b := NewBuilder[TestItem, int]()
+	b.KeyBy(keyFn).ParentBy(parentFn).WithItems([]TestItem{
+		{ID: 1, Name: "Root"},
+		{ID: 2, Name: "Child", ParentID: 1},
+		{ID: 3, Name: "Grandchild", ParentID: 2},
+		{ID: 4, Name: "OtherRoot"},
+	})
+
+	path, err := b.PathTo(3)
+	require.NoError(t, err)
+	require.Len(t, path, 3)
+	assert.Equal(t, 1, path[0].Item.ID)
+	assert.Equal(t, 2, path[1].Item.ID)
+	assert.Equal(t, 3, path[2].Item.ID)
+
+	path, err = b.PathTo(4)
+	require.NoError(t, err)
+	require.Len(t, path, 1)
+	assert.Equal(t, 4, path[0].Item.ID)
+
+	_, err = b.PathTo(999)
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+func TestBuilder_NodesAtDepth(t *testing.T) {
+	b := NewBuilder[TestItem, int]()
+	b.KeyBy(keyFn).ParentBy(parentFn).WithItems([]TestItem{
+		{ID: 1, Name: "Root"},
+		{ID: 2, Name: "Child1", ParentID: 1},
+		{ID: 3, Name: "Grandchild", ParentID: 2},
+		{ID: 4, Name: "OtherRoot"},
+		{ID: 5, Name: "Child2", ParentID: 4},
+	})
+
+	nodes, err := b.NodesAtDepth(2)
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+	assert.Equal(t, 2, nodes[0].Item.ID)
+	assert.Equal(t, 5, nodes[1].Item.ID)
+
+	nodes, err = b.NodesAtDepth(4)
+	require.NoError(t, err)
+	assert.Empty(t, nodes)
+}
+
+func TestBuilder_LevelOrder(t *testing.T) {
+	b := NewBuilder[TestItem, int]()
+	b.KeyBy(keyFn).ParentBy(parentFn).SortBy(sortFn).WithItems([]TestItem{
+		{ID: 1, Name: "Root", Sort: 2},
+		{ID: 2, Name: "Child1", ParentID: 1, Sort: 2},
+		{ID: 3, Name: "Grandchild", ParentID: 2},
+		{ID: 4, Name: "OtherRoot", Sort: 1},
+		{ID: 5, Name: "Child2", ParentID: 4},
+		{ID: 6, Name: "Child3", ParentID: 1, Sort: 1},
+	})
+
+	levels, err := b.LevelOrder()
+	require.NoError(t, err)
+	require.Len(t, levels, 3)
+
+	ids := make([][]int, len(levels))
+	for i, level := range levels {
+		for _, n := range level {
+			assert.Equal(t, i+1, n.Level)
+			ids[i] = append(ids[i], n.Item.ID)
+		}
+	}
+	assert.Equal(t, [][]int{{4, 1}, {5, 6, 2}, {3}}, ids)
+
+	empty, err := NewBuilder[TestItem, int]().KeyBy(keyFn).LevelOrder()
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+
+	_, err = NewBuilder[TestItem, int]().LevelOrder()
+	assert.ErrorIs(t, err, ErrKeyNotSet)
+}
+
+func TestBuilder_Walk(t *testing.T) {
+	b := NewBuilder[TestItem, int]()
+	b.KeyBy(keyFn).ParentBy(parentFn).WithItems([]TestItem{
+		{ID: 1, Name: "Root"},
+		{ID: 2, Name: "Child", ParentID: 1},
+		{ID: 3, Name: "OtherRoot"},
+	})
+
+	var ids, depths []int
+	err := b.Walk(func(n *Node[TestItem], depth int) bool {
+		ids = append(ids, n.Item.ID)
+		depths = append(depths, depth)
+		return n.Item.ID != 2
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, ids)
+	assert.Equal(t, []int{1, 2}, depths)
+
+	err = NewBuilder[TestItem, int]().Walk(func(*Node[TestItem], int) bool { return true })
+	assert.ErrorIs(t, err, ErrKeyNotSet)
+}
+
+func TestBuilder_Get(t *testing.T) {
+	b := NewBuilder[TestItem, int]()
+	b.KeyBy(keyFn).ParentBy(parentFn).WithItems([]TestItem{
+		{ID: 1, Name: "Root"},
+		{ID: 2, Name: "Child", ParentID: 1},
+		{ID: 3, Name: "Grandchild", ParentID: 2},
+	})
+
+	node, err := b.Get(2)
+	require.NoError(t, err)
+	require.Len(t, node.Children, 1)
+	assert.Equal(t, 3, node.Children[0].Item.ID)
+
+	node.Children[0].Item.Name = "Changed"
+	node.Children = nil
+
+	again, err := b.Get(2)
+	require.NoError(t, err)
+	require.Len(t, again.Children, 1)
+	assert.Equal(t, "Grandchild", again.Children[0].Item.Name)
+
+	_, err = b.Get(999)
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+func TestBuilder_Ancestors(t *testing.T) {
+	b := NewBuilder[TestItem, int]()
+	b.KeyBy(keyFn).ParentBy(parentFn).WithItems([]TestItem{
+		{ID: 1, Name: "Root"},
+		{ID: 2, Name: "Child", ParentID: 1},
+		{ID: 3, Name: "Grandchild", ParentID: 2},
+	})
+
+	ancestors, err := b.Ancestors(3)
+	require.NoError(t, err)
+	require.Len(t, ancestors, 2)
+	assert.Equal(t, 2, ancestors[0].Item.ID)
+	assert.Equal(t, 1, ancestors[1].Item.ID)
+
+	ancestors, err = b.Ancestors(1)
+	require.NoError(t, err)
+	assert.Empty(t, ancestors)
+
+	_, err = b.Ancestors(999)
+	assert.ErrorIs(t, err, ErrKeyNotFound)
+}
+
+func TestBuilder_Ancestors_Orphan(t *testing.T) {
+	b := NewBuilder[TestItem, int]()
+	b.KeyBy(keyFn).ParentBy(parentFn).WithItems([]TestItem{
+		{ID: 1, Name: "Root"},
+		{ID: 2, Name: "Orphan", ParentID: 99},
+	})
+
+	_, err := b.Ancestors(2)
+	assert.ErrorIs(t, err, ErrOrphanedNode)
+}
+
+func TestBuilder_SortByFunc_TieBreakByKey(t *testing.T) {
+	b := NewBuilder[TestItem, int]()
+	b.KeyBy(keyFn).ParentBy(parentFn).SortByFunc(func(a, b TestItem) int {
+		return cmp.Or(cmp.Compare(a.Sort, b.Sort), cmp.Compare(a.ID, b.ID))
+	}).WithItems([]TestItem{
+		{ID: 1, Name: "Root"},
+		{ID: 4, Name: "D", Sort: 1, ParentID: 1},
+		{ID: 3, Name: "C", Sort: 1, ParentID: 1},
+		{ID: 2, Name: "B", Sort: 2, ParentID: 1},
+	})
+
+	children, err := b.ChildrenOf(1)
+	require.NoError(t, err)
+	var ids []int
+	for _, c := range children {
+		ids = append(ids, c.Item.ID)
+	}
+	assert.Equal(t, []int{3, 4, 2}, ids)
+}
+
+func TestBuilder_SortDescending(t *testing.T) {
+	items := []TestItem{
+		{ID: 1, Name: "Root"},
+		{ID: 2, Name: "A", Sort: 1, ParentID: 1},
+		{ID: 3, Name: "B", Sort: 3, ParentID: 1},
+		{ID: 4, Name: "C", Sort: 2, ParentID: 1},
+		{ID: 5, Name: "D", Sort: 2, ParentID: 1},
+	}
+	childIDs := func(b *Builder[TestItem, int]) []int {
+		children, err := b.ChildrenOf(1)
+		require.NoError(t, err)
+		var ids []int
+		for _, c := range children {
+			ids = append(ids, c.Item.ID)
+		}
+		return ids
+	}
+
+	t.Run("sort by", func(t *testing.T) {
+		b := NewBuilder[TestItem, int]().KeyBy(keyFn).ParentBy(parentFn).
+			SortBy(sortFn).SortDescending().WithItems(items)
+		assert.Equal(t, []int{3, 4, 5, 2}, childIDs(b))
+	})
+
+	t.Run("sort by func", func(t *testing.T) {
+		b := NewBuilder[TestItem, int]().KeyBy(keyFn).ParentBy(parentFn).
+			SortByFunc(func(a, b TestItem) int { return strings.Compare(a.Name, b.Name) }).
+			SortDescending().WithItems(items)
+		assert.Equal(t, []int{5, 4, 3, 2}, childIDs(b))
+	})
+
+	t.Run("clone keeps direction", func(t *testing.T) {
+		b := NewBuilder[TestItem, int]().KeyBy(keyFn).ParentBy(parentFn).
+			SortBy(sortFn).SortDescending().WithItems(items)
+		assert.Equal(t, []int{3, 4, 5, 2}, childIDs(b.Clone()))
+	})
+}
+
+func TestBuilder_Prune(t *testing.T) {
+	b := NewBuilder[TestItem, int]()
+	b.KeyBy(keyFn).ParentBy(parentFn).WithItems([]TestItem{
+		{ID: 1, Name: "L1"},
+		{ID: 2, Name: "L2a", ParentID: 1},
+		{ID: 3, Name: "L2b", ParentID: 1},
+		{ID: 4, Name: "L3", ParentID: 2},
+		{ID: 5, Name: "L4", ParentID: 4},
+		{ID: 6, Name: "L5", ParentID: 5},
+	})
+
+	pruned, err := b.Prune(2)
+	require.NoError(t, err)
+
+	stats, err := pruned.Statistics()
+	require.NoError(t, err)
+	assert.Equal(t, 3, stats.TotalNodes)
+	assert.Equal(t, 2, stats.MaxDepth)
+	assert.Equal(t, 2, stats.LeafNodes)
+
+	original, err := b.Statistics()
+	require.NoError(t, err)
+	assert.Equal(t, 6, original.TotalNodes)
+	assert.Equal(t, 5, original.MaxDepth)
+
+	empty, err := b.Prune(0)
+	require.NoError(t, err)
+	stats, err = empty.Statistics()
+	require.NoError(t, err)
+	assert.Equal(t, 0, stats.TotalNodes)
+}
+
+func TestBuilder_Prune_InvalidTree(t *testing.T) {
+	b := NewBuilder[TestItem, int]()
+	b.KeyBy(keyFn).ParentBy(parentFn).WithItems([]TestItem{
+		{ID: 1, Name: "Orphan", ParentID: 99},
+	})
+
+	_, err := b.Prune(1)
+	assert.ErrorIs(t, err, ErrOrphanedNode)
+}
+
+func TestBuilder_Build_ReportsAllOrphans(t *testing.T) {
+	b := NewBuilder[TestItem, int]()
+	b.KeyBy(keyFn).ParentBy(parentFn).WithItems([]TestItem{
+		{ID: 1, Name: "Root"},
+		{ID: 2, Name: "Dangling", ParentID: 98},
+		{ID: 3, Name: "Child", ParentID: 1},
+		{ID: 4, Name: "Dangling", ParentID: 99},
+	})
+
+	_, err := b.Build()
+	require.ErrorIs(t, err, ErrOrphanedNode)
+	assert.EqualError(t, err, "orphaned node: 2, 4")
+}
+
+func TestBuilder_Orphans(t *testing.T) {
+	b := NewBuilder[TestItem, int]()
+	b.KeyBy(keyFn).ParentBy(parentFn).WithItems([]TestItem{
+		{ID: 1, Name: "Root"},
+		{ID: 2, Name: "SelfParent", ParentID: 2},
+		{ID: 3, Name: "Dangling", ParentID: 99},
+	})
+	b.AddItemWithParent(TestItem{ID: 4, Name: "ExplicitDangling"}, 100)
+
+	orphans, err := b.Orphans()
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 4}, orphans)
+
+	_, err = NewBuilder[TestItem, int]().Orphans()
+	assert.ErrorIs(t, err, ErrKeyNotSet)
+}
+
+func TestBuilder_Validate_DuplicateKeys(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(*Builder[TestItem, int])
+	}{
+		{
+			name: "AddItem",
+			setup: func(b *Builder[TestItem, int]) {
+				b.AddItem(TestItem{ID: 1, Name: "first"})
+				b.AddItem(TestItem{ID: 2, ParentID: 1})
+				b.AddItem(TestItem{ID: 1, Name: "second"})
+			},
+		},
+		{
+			name: "AddItemWithParent",
+			setup: func(b *Builder[TestItem, int]) {
+				b.AddItem(TestItem{ID: 1, Name: "first"})
+				b.AddItemWithParent(TestItem{ID: 1, Name: "second"}, 2)
+				b.AddItemWithParent(TestItem{ID: 2}, 1)
+			},
+		},
+		{
+			name: "WithItems",
+			setup: func(b *Builder[TestItem, int]) {
+				b.WithItems([]TestItem{{ID: 1, Name: "first"}, {ID: 1, Name: "second"}, {ID: 1, Name: "third"}})
+			},
+		},
+		{
+			name: "mixed",
+			setup: func(b *Builder[TestItem, int]) {
+				b.WithItems([]TestItem{{ID: 1, Name: "first"}})
+				b.AddItem(TestItem{ID: 1, Name: "second"})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewBuilder[TestItem, int]().KeyBy(keyFn).ParentBy(parentFn)
+			tt.setup(b)
+
+			var dupes []error
+			for _, err := range b.Validate() {
+				if errors.Is(err, ErrDuplicateKey) {
+					dupes = append(dupes, err)
+				}
+			}
+			require.Len(t, dupes, 1)
+			assert.EqualError(t, dupes[0], "duplicate key: 1")
+
+			_, err := b.Build()
+			assert.ErrorIs(t, err, ErrDuplicateKey)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package tree
+
+// Move records a key whose parent differs between two trees. HadParent and
+// HasParent are false when the key was or is a root.
+type Move[K comparable] struct {
+	Key       K
+	OldParent K
+	HadParent bool
+	NewParent K
+	HasParent bool
+}
+
+// TreeDiff lists the changes that turn one tree into another. Keys appear in
+// depth-first pre-order of the tree they belong to: the old tree for Removed
+// and the new tree for everything else.
+type TreeDiff[K comparable] struct {
+	Added   []K
+	Removed []K
+	Moved   []Move[K]
+	// Reordered holds keys that kept their parent but changed position
+	// relative to the siblings that also kept it.
+	Reordered []K
+}
+
+// Empty reports whether the two trees had the same structure.
+func (d TreeDiff[K]) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Moved) == 0 && len(d.Reordered) == 0
+}
+
+// Diff builds both builders and compares the resulting trees by key. Removing
+// a subtree lists every key in it.
+func Diff[T any, K comparable](oldBuilder, newBuilder *Builder[T, K]) (TreeDiff[K], error) {
+	oldTree, err := oldBuilder.Build()
+	if err != nil {
+		return TreeDiff[K]{}, err
+	}
+	newTree, err := newBuilder.Build()
+	if err != nil {
+		return TreeDiff[K]{}, err
+	}
+	return diffTrees(oldTree, newTree), nil
+}
+
+func diffTrees[T any, K comparable](oldTree, newTree *Tree[T, K]) TreeDiff[K] {
+	var d TreeDiff[K]
+
+	oldTree.Walk(func(n, _ *Node[T]) bool {
+		if k := oldTree.keyFn(n.Item); !newTree.ContainsKey(k) {
+			d.Removed = append(d.Removed, k)
+		}
+		return true
+	})
+
+	// kept reports whether key exists in both trees under the same parent.
+	kept := func(key K) bool {
+		if !oldTree.ContainsKey(key) || !newTree.ContainsKey(key) {
+			return false
+		}
+		oldParent, hadParent := oldTree.parentIdx[key]
+		newParent, hasParent := newTree.parentIdx[key]
+		return hadParent == hasParent && oldParent == newParent
+	}
+
+	reordered := make(map[K]struct{})
+	compareSiblings := func(oldSiblings, newSiblings []*Node[T]) {
+		var oldOrder, newOrder []K
+		for _, n := range oldSiblings {
+			if k := oldTree.keyFn(n.Item); kept(k) {
+				oldOrder = append(oldOrder, k)
+			}
+		}
+		for _, n := range newSiblings {
+			if k := newTree.keyFn(n.Item); kept(k) {
+				newOrder = append(newOrder, k)
+			}
+		}
+		for i := range newOrder {
+			if newOrder[i] != oldOrder[i] {
+				reordered[newOrder[i]] = struct{}{}
+			}
+		}
+	}
+
+	compareSiblings(oldTree.roots, newTree.roots)
+	newTree.Walk(func(n, _ *Node[T]) bool {
+		k := newTree.keyFn(n.Item)
+		if old, ok := oldTree.cache[k]; ok {
+			compareSiblings(old.Children, n.Children)
+		}
+		return true
+	})
+
+	newTree.Walk(func(n, _ *Node[T]) bool {
+		k := newTree.keyFn(n.Item)
+		switch {
+		case !oldTree.ContainsKey(k):
+			d.Added = append(d.Added, k)
+		case !kept(k):
+			oldParent, hadParent := oldTree.parentIdx[k]
+			newParent, hasParent := newTree.parentIdx[k]
+			d.Moved = append(d.Moved, Move[K]{
+				Key:       k,
+				OldParent: oldParent,
+				HadParent: hadParent,
+				NewParent: newParent,
+				HasParent: hasParent,
+			})
+		default:
+			if _, ok := reordered[k]; ok {
+				d.Reordered = append(d.Reordered, k)
+			}
+		}
+		return true
+	})
+
+	return d
+}
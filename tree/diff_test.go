@@ -0,0 +1,86 @@
+package tree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func diffBuilder(items []TestItem) *Builder[TestItem, int] {
+	return NewBuilder[TestItem, int]().KeyBy(keyFn).ParentBy(parentFn).SortBy(sortFn).WithItems(items)
+}
+
+func menuItems() []TestItem {
+	return []TestItem{
+		{ID: 1, Name: "Home", Sort: 1},
+		{ID: 2, Name: "Settings", Sort: 2},
+		{ID: 3, Name: "Profile", ParentID: 2, Sort: 1},
+		{ID: 4, Name: "Security", ParentID: 2, Sort: 2},
+		{ID: 5, Name: "Reports", Sort: 3},
+		{ID: 6, Name: "Daily", ParentID: 5, Sort: 1},
+		{ID: 7, Name: "Weekly", ParentID: 5, Sort: 2},
+	}
+}
+
+func TestDiff_Identical(t *testing.T) {
+	d, err := Diff(diffBuilder(menuItems()), diffBuilder(menuItems()))
+	require.NoError(t, err)
+	assert.True(t, d.Empty())
+}
+
+func TestDiff_AddedLeaf(t *testing.T) {
+	updated := append(menuItems(), TestItem{ID: 8, Name: "Billing", ParentID: 2, Sort: 0})
+
+	d, err := Diff(diffBuilder(menuItems()), diffBuilder(updated))
+	require.NoError(t, err)
+	assert.Equal(t, []int{8}, d.Added)
+	assert.Empty(t, d.Removed)
+	assert.Empty(t, d.Moved)
+	// Adding a sibling in front does not reorder the existing ones.
+	assert.Empty(t, d.Reordered)
+}
+
+func TestDiff_RemovedSubtree(t *testing.T) {
+	updated := menuItems()[:4]
+
+	d, err := Diff(diffBuilder(menuItems()), diffBuilder(updated))
+	require.NoError(t, err)
+	assert.Equal(t, []int{5, 6, 7}, d.Removed)
+	assert.Empty(t, d.Added)
+	assert.Empty(t, d.Moved)
+	assert.Empty(t, d.Reordered)
+}
+
+func TestDiff_ReparentedNode(t *testing.T) {
+	updated := menuItems()
+	updated[3].ParentID = 5 // Security moves under Reports.
+	updated[0].ParentID = 2 // Home moves from the roots under Settings.
+
+	d, err := Diff(diffBuilder(menuItems()), diffBuilder(updated))
+	require.NoError(t, err)
+	assert.Equal(t, []Move[int]{
+		{Key: 1, HadParent: false, NewParent: 2, HasParent: true},
+		{Key: 4, OldParent: 2, HadParent: true, NewParent: 5, HasParent: true},
+	}, d.Moved)
+	assert.Empty(t, d.Added)
+	assert.Empty(t, d.Removed)
+	assert.Empty(t, d.Reordered)
+}
+
+func TestDiff_Reordered(t *testing.T) {
+	updated := menuItems()
+	updated[5].Sort, updated[6].Sort = 2, 1 // Swap Daily and Weekly.
+
+	d, err := Diff(diffBuilder(menuItems()), diffBuilder(updated))
+	require.NoError(t, err)
+	assert.Equal(t, []int{7, 6}, d.Reordered)
+	assert.Empty(t, d.Moved)
+}
+
+func TestDiff_InvalidTree(t *testing.T) {
+	broken := diffBuilder([]TestItem{{ID: 1, ParentID: 99}})
+
+	_, err := Diff(diffBuilder(menuItems()), broken)
+	assert.ErrorIs(t, err, ErrOrphanedNode)
+}
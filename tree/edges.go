@@ -0,0 +1,56 @@
+package tree
+
+import (
+	"errors"
+	"fmt"
+)
+
+// FromEdges returns a builder whose items are the keys of a {child, parent}
+// edge list. An edge with a zero-value parent makes its child a root, and
+// parents that never appear as a child become roots as well. When sortOf is
+// not nil, siblings are ordered by it; otherwise they keep edge order.
+//
+// Self-loop edges cannot be expressed as items, because a key that names
+// itself as parent is treated as a root, so they are reported as ErrCycle and
+// no builder is returned. A child listed in more than one edge is kept once
+// per edge, so Validate and Build report it as ErrDuplicateKey.
+func FromEdges[K comparable](edges [][2]K, sortOf func(K) int) (*Builder[K, K], error) {
+	var errs []error
+	for _, e := range edges {
+		if e[0] == e[1] {
+			errs = append(errs, fmt.Errorf("%w: %v", ErrCycle, e[0]))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	b := NewBuilder[K, K]().KeyBy(func(k K) K { return k })
+	if sortOf != nil {
+		b.SortBy(sortOf)
+	}
+
+	var zero K
+	children := make(map[K]struct{}, len(edges))
+	for _, e := range edges {
+		children[e[0]] = struct{}{}
+	}
+
+	seenRoots := make(map[K]struct{})
+	for _, e := range edges {
+		child, parent := e[0], e[1]
+		if parent == zero {
+			b.AddItem(child)
+			continue
+		}
+		b.AddItemWithParent(child, parent)
+		if _, ok := children[parent]; ok {
+			continue
+		}
+		if _, ok := seenRoots[parent]; !ok {
+			seenRoots[parent] = struct{}{}
+			b.AddItem(parent)
+		}
+	}
+	return b, nil
+}
@@ -0,0 +1,59 @@
+package tree
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromEdges(t *testing.T) {
+	order := map[string]int{"docs": 2, "src": 1, "api": 2, "cmd": 1}
+	b, err := FromEdges([][2]string{
+		{"src", "root"},
+		{"docs", "root"},
+		{"api", "src"},
+		{"cmd", "src"},
+		{"main.go", "cmd"},
+		{"other", ""},
+	}, func(k string) int { return order[k] })
+	require.NoError(t, err)
+	assert.Empty(t, b.Validate())
+
+	tree, err := b.Build()
+	require.NoError(t, err)
+	assert.Equal(t, 7, tree.Len())
+	assert.Len(t, tree.Roots(), 2)
+	assert.Equal(t, "root\n├── src\n│   ├── cmd\n│   │   └── main.go\n│   └── api\n└── docs\nother", tree.String())
+
+	depth, err := b.Depth("main.go")
+	require.NoError(t, err)
+	assert.Equal(t, 4, depth)
+}
+
+func TestFromEdges_SelfLoop(t *testing.T) {
+	b, err := FromEdges([][2]string{{"a", "root"}, {"b", "b"}}, nil)
+	assert.ErrorIs(t, err, ErrCycle)
+	assert.Contains(t, err.Error(), "b")
+	assert.Nil(t, b)
+}
+
+func TestFromEdges_DuplicateEdge(t *testing.T) {
+	b, err := FromEdges([][2]int{{2, 1}, {3, 1}, {2, 3}}, nil)
+	require.NoError(t, err)
+
+	errs := b.Validate()
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], ErrDuplicateKey)
+
+	_, err = b.Build()
+	assert.ErrorIs(t, err, ErrDuplicateKey)
+}
+
+func TestFromEdges_Cycle(t *testing.T) {
+	b, err := FromEdges([][2]int{{1, 2}, {2, 3}, {3, 1}}, nil)
+	require.NoError(t, err)
+
+	_, err = b.Build()
+	assert.ErrorIs(t, err, ErrCycle)
+}
@@ -1,6 +1,10 @@
 package tree
 
-import "slices"
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
 
 type Stats struct {
 	TotalNodes  int
@@ -11,7 +15,9 @@ type Stats struct {
 	AvgChildren float64
 }
 
-// Tree is a built tree indexed by key.
+// Tree is a built tree indexed by key. It is never modified after it is
+// built, so it is safe for concurrent reads. Methods that return nodes return
+// copies.
 type Tree[T any, K comparable] struct {
 	roots     []*Node[T]
 	cache     map[K]*Node[T]
@@ -84,6 +90,37 @@ func (t *Tree[T, K]) LeafNodes() []*Node[T] {
 	return leaves
 }
 
+// FindNodes returns copies of all nodes matching fn in depth-first pre-order.
+func (t *Tree[T, K]) FindNodes(fn func(*Node[T]) bool) []*Node[T] {
+	var nodes []*Node[T]
+	if fn == nil {
+		return nodes
+	}
+	t.Walk(func(n *Node[T], _ *Node[T]) bool {
+		if fn(n) {
+			nodes = append(nodes, cloneNode(n))
+		}
+		return true
+	})
+	return nodes
+}
+
+// FindNode returns a copy of the first node matching fn in depth-first pre-order.
+func (t *Tree[T, K]) FindNode(fn func(*Node[T]) bool) (*Node[T], bool) {
+	var found *Node[T]
+	if fn == nil {
+		return nil, false
+	}
+	t.Walk(func(n *Node[T], _ *Node[T]) bool {
+		if fn(n) {
+			found = cloneNode(n)
+			return false
+		}
+		return true
+	})
+	return found, found != nil
+}
+
 // Ancestors returns copies of ancestors from parent to root.
 func (t *Tree[T, K]) Ancestors(key K) ([]*Node[T], bool) {
 	if _, ok := t.cache[key]; !ok {
@@ -145,6 +182,32 @@ func (t *Tree[T, K]) Descendants(key K) ([]*Node[T], bool) {
 	return descendants, true
 }
 
+// CountDescendants returns the number of nodes below key, excluding key
+// itself, or 0 if key does not exist. It walks iteratively, so deep trees do
+// not grow the call stack.
+func (t *Tree[T, K]) CountDescendants(key K) int {
+	n, ok := t.cache[key]
+	if !ok {
+		return 0
+	}
+
+	count := 0
+	stack := slices.Clone(n.Children)
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		count++
+		stack = append(stack, cur.Children...)
+	}
+	return count
+}
+
+// IsLeaf reports whether key exists and has no children.
+func (t *Tree[T, K]) IsLeaf(key K) bool {
+	n, ok := t.cache[key]
+	return ok && len(n.Children) == 0
+}
+
 // Walk visits nodes in depth-first pre-order until fn returns false.
 func (t *Tree[T, K]) Walk(fn func(*Node[T], *Node[T]) bool) bool {
 	if fn == nil {
@@ -369,3 +432,68 @@ func (t *Tree[T, K]) Stats() Stats {
 		AvgDepth:    avgDepth,
 	}
 }
+
+// NodesAtDepth returns copies of all nodes at the 1-based depth in
+// depth-first pre-order.
+func (t *Tree[T, K]) NodesAtDepth(depth int) []*Node[T] {
+	var nodes []*Node[T]
+	if depth <= 0 {
+		return nodes
+	}
+	t.Walk(func(n *Node[T], _ *Node[T]) bool {
+		if n.Level == depth {
+			nodes = append(nodes, cloneNode(n))
+		}
+		return true
+	})
+	return nodes
+}
+
+// LevelOrder returns copies of all nodes grouped by depth using a
+// breadth-first traversal: index i holds the nodes at depth i+1, so roots are
+// at index 0. Within a level, nodes are ordered by parent and then by sibling
+// order.
+func (t *Tree[T, K]) LevelOrder() [][]*Node[T] {
+	var levels [][]*Node[T]
+	current := t.roots
+	for len(current) > 0 {
+		level := make([]*Node[T], len(current))
+		var next []*Node[T]
+		for i, n := range current {
+			level[i] = cloneNode(n)
+			next = append(next, n.Children...)
+		}
+		levels = append(levels, level)
+		current = next
+	}
+	return levels
+}
+
+// String renders the tree as indented text in sibling order, one key per line,
+// using the same connectors as the Unix tree command.
+func (t *Tree[T, K]) String() string {
+	var sb strings.Builder
+
+	var render func(n *Node[T], prefix string, last bool)
+	render = func(n *Node[T], prefix string, last bool) {
+		connector, childPrefix := "├── ", "│   "
+		if last {
+			connector, childPrefix = "└── ", "    "
+		}
+		fmt.Fprintf(&sb, "\n%s%s%v", prefix, connector, t.keyFn(n.Item))
+		for i, c := range n.Children {
+			render(c, prefix+childPrefix, i == len(n.Children)-1)
+		}
+	}
+
+	for i, r := range t.roots {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		fmt.Fprintf(&sb, "%v", t.keyFn(r.Item))
+		for j, c := range r.Children {
+			render(c, "", j == len(r.Children)-1)
+		}
+	}
+	return sb.String()
+}
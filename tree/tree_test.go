@@ -1,6 +1,7 @@
 package tree
 
 import (
+	"strings"
 	"sync"
 	"testing"
 
@@ -240,6 +241,48 @@ func TestTree_Descendants(t *testing.T) {
 	assert.False(t, ok)
 }
 
+func TestTree_CountDescendantsAndIsLeaf(t *testing.T) {
+	tree, err := NewBuilder[TestItem, int]().KeyBy(keyFn).ParentBy(parentFn).WithItems([]TestItem{
+		{ID: 1, Name: "Root"},
+		{ID: 2, Name: "Child", ParentID: 1},
+		{ID: 3, Name: "Grandchild", ParentID: 2},
+		{ID: 4, Name: "Child2", ParentID: 1},
+		{ID: 5, Name: "Grandchild2", ParentID: 4},
+		{ID: 6, Name: "OtherRoot"},
+	}).Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, tree.CountDescendants(1))
+	assert.Equal(t, 1, tree.CountDescendants(2))
+	assert.Zero(t, tree.CountDescendants(3))
+	assert.Zero(t, tree.CountDescendants(999))
+
+	assert.True(t, tree.IsLeaf(3))
+	assert.True(t, tree.IsLeaf(6))
+	assert.False(t, tree.IsLeaf(1))
+	assert.False(t, tree.IsLeaf(999))
+}
+
+func TestBuilder_CountDescendantsAndIsLeaf(t *testing.T) {
+	b := NewBuilder[TestItem, int]().KeyBy(keyFn).ParentBy(parentFn)
+	for i := 1; i <= 10000; i++ {
+		b.AddItem(TestItem{ID: i, ParentID: i - 1})
+	}
+
+	count, err := b.CountDescendants(1)
+	require.NoError(t, err)
+	assert.Equal(t, 9999, count)
+
+	leaf, err := b.IsLeaf(10000)
+	require.NoError(t, err)
+	assert.True(t, leaf)
+
+	_, err = NewBuilder[TestItem, int]().CountDescendants(1)
+	assert.ErrorIs(t, err, ErrKeyNotSet)
+	_, err = NewBuilder[TestItem, int]().IsLeaf(1)
+	assert.ErrorIs(t, err, ErrKeyNotSet)
+}
+
 func TestTree_Subtree_SetsRootLevel(t *testing.T) {
 	b := NewBuilder[TestItem, int]()
 	b.KeyBy(keyFn).ParentBy(parentFn).WithItems([]TestItem{
@@ -287,3 +330,85 @@ func TestTree_Subtree_ConcurrentReaders(t *testing.T) {
 	close(errCh)
 	assert.Empty(t, errCh)
 }
+
+func TestTree_String(t *testing.T) {
+	b := NewBuilder[TestItem, int]()
+	b.KeyBy(keyFn).ParentBy(parentFn).WithItems([]TestItem{
+		{ID: 1, Name: "Root"},
+		{ID: 2, Name: "Child1", ParentID: 1},
+		{ID: 3, Name: "Child2", ParentID: 1},
+		{ID: 4, Name: "Grandchild1", ParentID: 2},
+		{ID: 5, Name: "Grandchild2", ParentID: 2},
+	})
+	tree, err := b.Build()
+	require.NoError(t, err)
+
+	want := strings.Join([]string{
+		"1",
+		"├── 2",
+		"│   ├── 4",
+		"│   └── 5",
+		"└── 3",
+	}, "\n")
+	assert.Equal(t, want, tree.String())
+	assert.Equal(t, want, b.String())
+}
+
+func TestTree_String_Forest(t *testing.T) {
+	b := NewBuilder[TestItem, int]()
+	b.KeyBy(keyFn).ParentBy(parentFn).WithItems([]TestItem{
+		{ID: 1, Name: "A"},
+		{ID: 2, Name: "B"},
+		{ID: 3, Name: "C", ParentID: 2},
+	})
+
+	assert.Equal(t, "1\n2\n└── 3", b.String())
+}
+
+func TestBuilder_String_Invalid(t *testing.T) {
+	b := NewBuilder[TestItem, int]()
+	assert.Contains(t, b.String(), ErrKeyNotSet.Error())
+}
+
+func TestTree_FindNodes(t *testing.T) {
+	b := NewBuilder[TestItem, int]()
+	b.KeyBy(keyFn).ParentBy(parentFn).WithItems([]TestItem{
+		{ID: 1, Name: "Root"},
+		{ID: 2, Name: "Child1", ParentID: 1},
+		{ID: 3, Name: "Child2", ParentID: 1},
+		{ID: 4, Name: "Grandchild", ParentID: 2},
+	})
+	tree, err := b.Build()
+	require.NoError(t, err)
+
+	isLeaf := func(n *Node[TestItem]) bool { return len(n.Children) == 0 }
+	assert.Equal(t, tree.LeafNodes(), tree.FindNodes(isLeaf))
+
+	nodes, err := b.FindNodes(isLeaf)
+	require.NoError(t, err)
+	assert.Equal(t, tree.LeafNodes(), nodes)
+
+	assert.Empty(t, tree.FindNodes(nil))
+}
+
+func TestTree_FindNode(t *testing.T) {
+	b := NewBuilder[TestItem, int]()
+	b.KeyBy(keyFn).ParentBy(parentFn).WithItems([]TestItem{
+		{ID: 1, Name: "Root"},
+		{ID: 2, Name: "Child1", ParentID: 1},
+		{ID: 3, Name: "Child2", ParentID: 1},
+	})
+	tree, err := b.Build()
+	require.NoError(t, err)
+
+	node, ok := tree.FindNode(func(n *Node[TestItem]) bool { return n.Level == 2 })
+	require.True(t, ok)
+	assert.Equal(t, 2, node.Item.ID)
+
+	node.Item.Name = "Changed"
+	original, _ := tree.Get(2)
+	assert.Equal(t, "Child1", original.Item.Name)
+
+	_, ok = tree.FindNode(func(n *Node[TestItem]) bool { return n.Level == 3 })
+	assert.False(t, ok)
+}